@@ -0,0 +1,16 @@
+package common
+
+// ArchitectureConstraintsPolicy is a type derived from string used to represent how the pod placement controller
+// handles the pods whose nodeSelector or required node affinity already constrain the architecture of the nodes.
+// +kubebuilder:validation:Enum=Skip;Intersect
+type ArchitectureConstraintsPolicy string
+
+const (
+	// ArchitectureConstraintsPolicySkip leaves the architecture constraints set by the user unchanged: the
+	// nodeSelectorTerms constraining the architecture are skipped, and the pods constraining the architecture in their
+	// nodeSelector are ignored.
+	ArchitectureConstraintsPolicySkip ArchitectureConstraintsPolicy = "Skip"
+	// ArchitectureConstraintsPolicyIntersect narrows the architectures allowed by the user to those supported by the
+	// images of the pod.
+	ArchitectureConstraintsPolicyIntersect ArchitectureConstraintsPolicy = "Intersect"
+)
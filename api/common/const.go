@@ -9,4 +9,8 @@ const (
 	NodeAffinityScoringPluginName Plugin = iota
 	// ENoExecPlugin checks the ENoExecEvent resources.
 	ExecFormatErrorMonitorPluginName
+	// RunningPodsScannerPluginName checks the running pods scheduled on incompatible architectures.
+	RunningPodsScannerPluginName
+	// CostAwareScoringPluginName sets the preferred node affinity based on the per-architecture cost hints.
+	CostAwareScoringPluginName
 )
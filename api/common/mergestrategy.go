@@ -0,0 +1,20 @@
+package common
+
+// MergeStrategy is a type derived from string used to represent how the preferred node affinity terms of the
+// configurations matching a pod are merged.
+// +kubebuilder:validation:Enum=FirstMatchWins;MergeByMaxWeight;Sum;Override
+type MergeStrategy string
+
+const (
+	// MergeStrategyFirstMatchWins sets, for each architecture, the weight of the highest priority configuration
+	// providing it. The architectures already set by a higher priority configuration are skipped.
+	MergeStrategyFirstMatchWins MergeStrategy = "FirstMatchWins"
+	// MergeStrategyMergeByMaxWeight sets, for each architecture, the maximum weight among the configurations
+	// providing it.
+	MergeStrategyMergeByMaxWeight MergeStrategy = "MergeByMaxWeight"
+	// MergeStrategySum sets, for each architecture, the sum of the weights of the configurations providing it,
+	// capped at 100.
+	MergeStrategySum MergeStrategy = "Sum"
+	// MergeStrategyOverride only applies the highest priority configuration and ignores the others.
+	MergeStrategyOverride MergeStrategy = "Override"
+)
@@ -0,0 +1,14 @@
+package common
+
+// NamespaceEnrollmentMode is a type derived from string used to represent how the namespaces are enrolled in the
+// pod placement operand.
+// +kubebuilder:validation:Enum=OptOut;OptIn
+type NamespaceEnrollmentMode string
+
+const (
+	// NamespaceEnrollmentModeOptOut enrolls all the namespaces selected by the namespaceSelector.
+	NamespaceEnrollmentModeOptOut NamespaceEnrollmentMode = "OptOut"
+	// NamespaceEnrollmentModeOptIn enrolls only the namespaces labeled with multiarch.openshift.io/include-pod-placement
+	// that are also selected by the namespaceSelector.
+	NamespaceEnrollmentModeOptIn NamespaceEnrollmentMode = "OptIn"
+)
@@ -0,0 +1,18 @@
+package common
+
+// NoCompatibleNodesPolicy is a type derived from string used to represent how the pod placement controller handles the
+// pods whose images support none of the architectures of the ready and schedulable nodes.
+// +kubebuilder:validation:Enum=Ungate;KeepGated;Fallback
+type NoCompatibleNodesPolicy string
+
+const (
+	// NoCompatibleNodesPolicyUngate sets the node affinity to the architectures supported by the images and removes
+	// the scheduling gate: the pod stays pending until a compatible node joins the cluster.
+	NoCompatibleNodesPolicyUngate NoCompatibleNodesPolicy = "Ungate"
+	// NoCompatibleNodesPolicyKeepGated keeps the scheduling gate and leaves the pod unchanged until a compatible node
+	// joins the cluster.
+	NoCompatibleNodesPolicyKeepGated NoCompatibleNodesPolicy = "KeepGated"
+	// NoCompatibleNodesPolicyFallback sets the node affinity to the fallback architecture and removes the scheduling
+	// gate.
+	NoCompatibleNodesPolicyFallback NoCompatibleNodesPolicy = "Fallback"
+)
@@ -0,0 +1,13 @@
+package common
+
+// PlacementMode is a type derived from string used to represent how the pod placement operand acts on the pods.
+// +kubebuilder:validation:Enum=Enforce;Audit
+type PlacementMode string
+
+const (
+	// PlacementModeEnforce gates the pods and sets their node affinity according to the images' architectures.
+	PlacementModeEnforce PlacementMode = "Enforce"
+	// PlacementModeAudit does not gate the pods. The pods are inspected asynchronously and the node affinity that
+	// would have been set is only recorded in the pods' metadata, events and metrics.
+	PlacementModeAudit PlacementMode = "Audit"
+)
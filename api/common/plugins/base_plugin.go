@@ -24,6 +24,8 @@ import "github.com/openshift/multiarch-tuning-operator/api/common"
 // +kubebuilder:object:generate=true
 type LocalPlugins struct {
 	NodeAffinityScoring *NodeAffinityScoring `json:"nodeAffinityScoring,omitempty"`
+
+	CostAwareScoring *CostAwareScoring `json:"costAwareScoring,omitempty"`
 }
 
 // localPluginChecks is a map that associates a plugin name with a function that can
@@ -32,6 +34,9 @@ var localPluginChecks = map[common.Plugin]func(lp *LocalPlugins) bool{
 	common.NodeAffinityScoringPluginName: func(lp *LocalPlugins) bool {
 		return lp.NodeAffinityScoring != nil && lp.NodeAffinityScoring.IsEnabled()
 	},
+	common.CostAwareScoringPluginName: func(lp *LocalPlugins) bool {
+		return lp.CostAwareScoring != nil && lp.CostAwareScoring.IsEnabled()
+	},
 }
 
 // PluginEnabled provides a generic and safe way to check if a specific plugin is enabled.
@@ -43,12 +48,29 @@ func (lp *LocalPlugins) PluginEnabled(plugin common.Plugin) bool {
 	return false
 }
 
+// AnyPluginEnabled returns true if at least one of the local plugins is enabled.
+func (lp *LocalPlugins) AnyPluginEnabled() bool {
+	if lp == nil {
+		return false
+	}
+	for _, checkFunc := range localPluginChecks {
+		if checkFunc(lp) {
+			return true
+		}
+	}
+	return false
+}
+
 // Plugins represents the plugins configuration for cluster pod placement config.
 // +kubebuilder:object:generate=true
 type Plugins struct {
 	NodeAffinityScoring *NodeAffinityScoring `json:"nodeAffinityScoring,omitempty"`
 
 	ExecFormatErrorMonitor *ExecFormatErrorMonitor `json:"execFormatErrorMonitor,omitempty"`
+
+	RunningPodsScanner *RunningPodsScanner `json:"runningPodsScanner,omitempty"`
+
+	CostAwareScoring *CostAwareScoring `json:"costAwareScoring,omitempty"`
 }
 
 // pluginChecks is a map that associates a plugin name with a function that can
@@ -60,6 +82,12 @@ var pluginChecks = map[common.Plugin]func(p *Plugins) bool{
 	common.ExecFormatErrorMonitorPluginName: func(p *Plugins) bool {
 		return p.ExecFormatErrorMonitor != nil && p.ExecFormatErrorMonitor.IsEnabled()
 	},
+	common.RunningPodsScannerPluginName: func(p *Plugins) bool {
+		return p.RunningPodsScanner != nil && p.RunningPodsScanner.IsEnabled()
+	},
+	common.CostAwareScoringPluginName: func(p *Plugins) bool {
+		return p.CostAwareScoring != nil && p.CostAwareScoring.IsEnabled()
+	},
 }
 
 // PluginEnabled provides a generic and safe way to check if a specific plugin is enabled.
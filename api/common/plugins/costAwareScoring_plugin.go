@@ -0,0 +1,101 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// CostAwareScoringPluginName stores the name for the CostAwareScoring.
+	CostAwareScoringPluginName = "CostAwareScoring"
+	// DefaultCostAwareScoringMaxWeight is the default weight of the preferred node affinity term of the cheapest
+	// architecture.
+	DefaultCostAwareScoringMaxWeight = 50
+)
+
+// CostHintsSource is a type derived from string used to represent where the per-architecture cost hints are read from.
+// +kubebuilder:validation:Enum=NodeLabels;ConfigMap
+type CostHintsSource string
+
+const (
+	// CostHintsSourceNodeLabels reads the cost of each architecture from the multiarch.openshift.io/architecture-cost
+	// label of its nodes. The cost of an architecture is the average of the values set on its nodes.
+	CostHintsSourceNodeLabels CostHintsSource = "NodeLabels"
+	// CostHintsSourceConfigMap reads the cost of each architecture from the architecture-cost-hints ConfigMap in the
+	// namespace of the operator. Each key of the ConfigMap is an architecture and its value is the cost.
+	CostHintsSourceConfigMap CostHintsSource = "ConfigMap"
+)
+
+// CostAwareScoring is a plugin that sets preferred node affinity terms favoring the cheapest architectures, based on
+// per-architecture cost hints. The cheapest architecture gets the MaxWeight weight and the other architectures get
+// a weight inversely proportional to their cost.
+type CostAwareScoring struct {
+	BasePlugin `json:",inline"`
+
+	// Source defines where the per-architecture cost hints are read from.
+	// Valid values are: "NodeLabels", "ConfigMap".
+	// Defaults to NodeLabels.
+	// +optional
+	// +kubebuilder:default=NodeLabels
+	Source CostHintsSource `json:"source,omitempty"`
+
+	// MaxWeight is the weight of the preferred node affinity term of the cheapest architecture, in the range 1-100.
+	// It bounds how strongly the cheapest architecture is preferred over the other ones.
+	// Defaults to 50.
+	// +optional
+	// +kubebuilder:default=50
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	MaxWeight int32 `json:"maxWeight,omitempty"`
+}
+
+// Name returns the name of the CostAwareScoringPluginName.
+func (c *CostAwareScoring) Name() string {
+	return CostAwareScoringPluginName
+}
+
+// PlatformTerms returns the preferred node affinity platform terms computed from the given per-architecture costs.
+// The architectures with a non-positive cost are ignored. The terms are sorted by architecture.
+func (c *CostAwareScoring) PlatformTerms(costs map[string]float64) []NodeAffinityScoringPlatformTerm {
+	maxWeight := c.MaxWeight
+	if maxWeight <= 0 {
+		maxWeight = DefaultCostAwareScoringMaxWeight
+	}
+	minCost := math.Inf(1)
+	for _, cost := range costs {
+		if cost > 0 {
+			minCost = math.Min(minCost, cost)
+		}
+	}
+	var terms []NodeAffinityScoringPlatformTerm
+	for architecture, cost := range costs {
+		if cost <= 0 {
+			continue
+		}
+		weight := int32(math.Round(float64(maxWeight) * minCost / cost))
+		terms = append(terms, NodeAffinityScoringPlatformTerm{
+			Architecture: architecture,
+			Weight:       max(weight, 1),
+		})
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		return terms[i].Architecture < terms[j].Architecture
+	})
+	return terms
+}
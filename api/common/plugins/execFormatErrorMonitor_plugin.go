@@ -17,14 +17,129 @@ limitations under the License.
 // +kubebuilder:object:generate=true
 package plugins
 
+import "time"
+
 const (
 	// ExecFormatErrorMonitorPluginName stores the namne for the ExecFormatErrorMonitor.
 	ExecFormatErrorMonitorPluginName = "execFormatErrorMonitor"
 )
 
+// ExecFormatErrorStorageBackend is the backend the ENoExecEvent daemons store the exec format errors in.
+// +kubebuilder:validation:Enum=ENoExecEvent;Event;Log
+type ExecFormatErrorStorageBackend string
+
+const (
+	// StorageBackendENoExecEvent stores the exec format errors as ENoExecEvent objects, published as events of the
+	// pods, and labels the pods, by the ENoExecEvent handler.
+	StorageBackendENoExecEvent ExecFormatErrorStorageBackend = "ENoExecEvent"
+	// StorageBackendEvent publishes the exec format errors as events of the pods, or of the nodes for the host
+	// processes, directly from the daemons. The pods are not labelled.
+	StorageBackendEvent ExecFormatErrorStorageBackend = "Event"
+	// StorageBackendLog writes the exec format errors as JSON lines in the logs of the daemons only.
+	StorageBackendLog ExecFormatErrorStorageBackend = "Log"
+)
+
+// ENoExecEventRetention is the retention policy of the ENoExecEvents reconciled by the ENoExecEvent handler. The
+// reconciled ENoExecEvents are deleted by the operator when they exceed any of the limits that are set.
+// +kubebuilder:validation:MinProperties=1
+type ENoExecEventRetention struct {
+	// MaxAgeHours is the number of hours the ENoExecEvents are kept after their reconciliation.
+	// If not set, the ENoExecEvents are kept regardless of their age.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8760
+	MaxAgeHours int32 `json:"maxAgeHours,omitempty"`
+
+	// MaxPerNamespace is the number of the most recently reconciled ENoExecEvents kept for each namespace of the
+	// pods they refer to. The node-scoped ENoExecEvents are counted together. If not set, the number of ENoExecEvents
+	// is not limited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	MaxPerNamespace int32 `json:"maxPerNamespace,omitempty"`
+}
+
+// MaxAge returns the time the ENoExecEvents are kept after their reconciliation. It is zero if their age is not
+// limited.
+func (r *ENoExecEventRetention) MaxAge() time.Duration {
+	if r == nil || r.MaxAgeHours <= 0 {
+		return 0
+	}
+	return time.Duration(r.MaxAgeHours) * time.Hour
+}
+
 // ExecFormatErrorMonitor is a plugin that provides Exec Format Errors events reporting and monitoring
 type ExecFormatErrorMonitor struct {
 	BasePlugin `json:",inline"`
+
+	// CRISocket is the path, on the nodes, of the unix socket of the CRI runtime. The ENoExecEvent daemon queries the
+	// runtime to get the pods of the processes failing with an exec format error.
+	// If not set, the daemon looks for the sockets of CRI-O, containerd and k3s in their default locations under /run.
+	// The socket of cri-dockerd, e.g., /run/cri-dockerd.sock, must be set.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.+$`
+	CRISocket string `json:"criSocket,omitempty"`
+
+	// ReportHostProcesses enables the reporting of the exec format errors of the processes that do not run in the
+	// containers of a pod, e.g., systemd services. They are reported as node-scoped ENoExecEvents, published as
+	// events of the nodes.
+	// +optional
+	ReportHostProcesses bool `json:"reportHostProcesses,omitempty"`
+
+	// MaxEvents is the number of ENOEXEC events each ENoExecEvent daemon can hold in its eBPF ring buffer and in the
+	// queue of the events waiting to be stored. The events raised when they are full are dropped.
+	// If not set, the daemon holds 256 events.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65536
+	MaxEvents int32 `json:"maxEvents,omitempty"`
+
+	// RateLimit is the maximum number of writes per second of the ENoExecEvent objects of each ENoExecEvent daemon.
+	// If not set, the daemon writes 5 objects per second.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	RateLimit int32 `json:"rateLimit,omitempty"`
+
+	// Burst is the maximum number of writes of the ENoExecEvent objects each ENoExecEvent daemon can do at once,
+	// above the RateLimit. If not set, the daemon can write 10 objects at once.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	Burst int32 `json:"burst,omitempty"`
+
+	// TimeoutSeconds is the time an ENOEXEC event waits for the rate limiter before it is dropped by the ENoExecEvent
+	// daemon. If not set, the events are dropped after 60 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// StorageBackend is the backend the ENoExecEvent daemons store the exec format errors in: ENoExecEvent objects,
+	// reconciled by the ENoExecEvent handler, core/v1 Events written by the daemons, or the logs of the daemons.
+	// The ENoExecEvent handler is only deployed for the ENoExecEvent backend. If not set, the ENoExecEvent backend is
+	// used.
+	// +optional
+	StorageBackend ExecFormatErrorStorageBackend `json:"storageBackend,omitempty"`
+
+	// Retention keeps the ENoExecEvents after their reconciliation by the ENoExecEvent handler, with the outcome of
+	// the reconciliation in their status, so that the past exec format errors can be queried. The ENoExecEvents
+	// exceeding the retention limits are deleted by the operator. If not set, the ENoExecEvents are deleted once they
+	// are reconciled. It only applies to the ENoExecEvent storage backend.
+	// +optional
+	Retention *ENoExecEventRetention `json:"retention,omitempty"`
+}
+
+// UsesENoExecEvents returns true if the exec format errors are stored as ENoExecEvent objects, which requires the
+// ENoExecEvent handler.
+func (b *ExecFormatErrorMonitor) UsesENoExecEvents() bool {
+	return b == nil || b.StorageBackend == "" || b.StorageBackend == StorageBackendENoExecEvent
+}
+
+// RetainsENoExecEvents returns true if the ENoExecEvents are kept after their reconciliation by the ENoExecEvent
+// handler.
+func (b *ExecFormatErrorMonitor) RetainsENoExecEvents() bool {
+	return b != nil && b.Retention != nil && b.UsesENoExecEvents()
 }
 
 // Name returns the name of the ExecFormatErrorMonitorPluginName.
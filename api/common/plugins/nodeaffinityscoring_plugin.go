@@ -23,6 +23,19 @@ const (
 	NodeAffinityScoringPluginName = "NodeAffinityScoring"
 )
 
+// NodeAffinityScoringMode is a type derived from string used to represent how the weights of the preferred node
+// affinity terms are computed.
+// +kubebuilder:validation:Enum=Static;Dynamic
+type NodeAffinityScoringMode string
+
+const (
+	// NodeAffinityScoringModeStatic uses the weights set in the platforms.
+	NodeAffinityScoringModeStatic NodeAffinityScoringMode = "Static"
+	// NodeAffinityScoringModeDynamic computes the weights from the free allocatable CPU and memory of the
+	// schedulable nodes of each architecture.
+	NodeAffinityScoringModeDynamic NodeAffinityScoringMode = "Dynamic"
+)
+
 // NodeAffinityScoring is the plugin that implements the ScorePlugin interface.
 type NodeAffinityScoring struct {
 	BasePlugin `json:",inline"`
@@ -30,6 +43,23 @@ type NodeAffinityScoring struct {
 	// Platforms is a required field and must contain at least one entry.
 	// +kubebuilder:validation:MinItems=1
 	Platforms []NodeAffinityScoringPlatformTerm `json:"platforms" protobuf:"bytes,2,opt,name=platforms"`
+
+	// Mode defines how the weights of the preferred node affinity terms are computed.
+	// Valid values are: "Static", "Dynamic".
+	// With Static, the weights set in the platforms are used.
+	// With Dynamic, the weights are periodically recomputed from the share of the free allocatable CPU and memory
+	// of the schedulable nodes of each architecture listed in the platforms, so that the pods are steered to the
+	// architecture with the most headroom. The weights set in the platforms are used until the free capacity of the
+	// nodes is known.
+	// Defaults to Static.
+	// +optional
+	// +kubebuilder:default=Static
+	Mode NodeAffinityScoringMode `json:"mode,omitempty" protobuf:"bytes,3,opt,name=mode"`
+}
+
+// IsDynamic returns true if the weights are computed from the free capacity of the nodes.
+func (n *NodeAffinityScoring) IsDynamic() bool {
+	return n.Mode == NodeAffinityScoringModeDynamic
 }
 
 // ValidateArchitecturesSet checks whether duplicate architectures are set in NodeAffinityScoring
@@ -1,7 +1,9 @@
 package plugins
 
 import (
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestBasePlugin_IsEnabled(t *testing.T) {
@@ -45,3 +47,131 @@ func TestExecFormatErrorMonitor_Name(t *testing.T) {
 		t.Errorf("Expected plugin name %s, but got %s", ExecFormatErrorMonitorPluginName, plugin.Name())
 	}
 }
+
+func TestExecFormatErrorMonitor_RetainsENoExecEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		plugin *ExecFormatErrorMonitor
+		want   bool
+	}{
+		{"Plugin not set", nil, false},
+		{"Retention not set", &ExecFormatErrorMonitor{}, false},
+		{"Retention set", &ExecFormatErrorMonitor{Retention: &ENoExecEventRetention{MaxAgeHours: 24}}, true},
+		{"Retention set with the Event storage backend", &ExecFormatErrorMonitor{
+			StorageBackend: StorageBackendEvent,
+			Retention:      &ENoExecEventRetention{MaxAgeHours: 24},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.plugin.RetainsENoExecEvents(); got != tt.want {
+				t.Errorf("Expected RetainsENoExecEvents() to be %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestENoExecEventRetention_MaxAge(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention *ENoExecEventRetention
+		want      time.Duration
+	}{
+		{"Retention not set", nil, 0},
+		{"Max age not set", &ENoExecEventRetention{MaxPerNamespace: 10}, 0},
+		{"Max age set", &ENoExecEventRetention{MaxAgeHours: 48}, 48 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.retention.MaxAge(); got != tt.want {
+				t.Errorf("Expected MaxAge() to be %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRunningPodsScanner_Name(t *testing.T) {
+	plugin := &RunningPodsScanner{}
+
+	if plugin.Name() != RunningPodsScannerPluginName {
+		t.Errorf("Expected plugin name %s, but got %s", RunningPodsScannerPluginName, plugin.Name())
+	}
+}
+
+func TestRunningPodsScanner_Interval(t *testing.T) {
+	tests := []struct {
+		name            string
+		intervalMinutes int32
+		want            time.Duration
+	}{
+		{"Interval not set", 0, DefaultRunningPodsScanIntervalMinutes * time.Minute},
+		{"Interval set", 5, 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := &RunningPodsScanner{IntervalMinutes: tt.intervalMinutes}
+			if plugin.Interval() != tt.want {
+				t.Errorf("Expected Interval() to be %v, got %v", tt.want, plugin.Interval())
+			}
+		})
+	}
+}
+
+func TestCostAwareScoring_Name(t *testing.T) {
+	plugin := &CostAwareScoring{}
+
+	if plugin.Name() != CostAwareScoringPluginName {
+		t.Errorf("Expected plugin name %s, but got %s", CostAwareScoringPluginName, plugin.Name())
+	}
+}
+
+func TestCostAwareScoring_PlatformTerms(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxWeight int32
+		costs     map[string]float64
+		want      []NodeAffinityScoringPlatformTerm
+	}{
+		{"No cost hints", 50, nil, nil},
+		{"Cheapest architecture gets the max weight", 80, map[string]float64{"amd64": 1.0, "arm64": 0.5},
+			[]NodeAffinityScoringPlatformTerm{{Architecture: "amd64", Weight: 40}, {Architecture: "arm64", Weight: 80}}},
+		{"Max weight not set", 0, map[string]float64{"amd64": 2.0, "arm64": 1.0},
+			[]NodeAffinityScoringPlatformTerm{{Architecture: "amd64", Weight: 25}, {Architecture: "arm64", Weight: 50}}},
+		{"Non-positive costs are ignored and weights are at least 1", 10, map[string]float64{"amd64": 100, "arm64": 1, "s390x": 0},
+			[]NodeAffinityScoringPlatformTerm{{Architecture: "amd64", Weight: 1}, {Architecture: "arm64", Weight: 10}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := &CostAwareScoring{MaxWeight: tt.maxWeight}
+			if got := plugin.PlatformTerms(tt.costs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected PlatformTerms() to be %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLocalPlugins_AnyPluginEnabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins *LocalPlugins
+		want    bool
+	}{
+		{"Nil LocalPlugins", nil, false},
+		{"No plugin configured", &LocalPlugins{}, false},
+		{"Disabled plugin", &LocalPlugins{NodeAffinityScoring: &NodeAffinityScoring{}}, false},
+		{"Enabled plugin", &LocalPlugins{NodeAffinityScoring: &NodeAffinityScoring{BasePlugin: BasePlugin{Enabled: true}}}, true},
+		{"Enabled cost aware plugin", &LocalPlugins{CostAwareScoring: &CostAwareScoring{BasePlugin: BasePlugin{Enabled: true}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.plugins.AnyPluginEnabled(); got != tt.want {
+				t.Errorf("Expected AnyPluginEnabled() to be %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import "time"
+
+const (
+	// RunningPodsScannerPluginName stores the name for the RunningPodsScanner.
+	RunningPodsScannerPluginName = "runningPodsScanner"
+	// DefaultRunningPodsScanIntervalMinutes is the default interval between two scans of the running pods.
+	DefaultRunningPodsScanIntervalMinutes = 60
+)
+
+// RunningPodsScanner is a plugin that periodically inspects the images of the running pods and reports the ones
+// running on nodes whose architecture is not supported by their images. The running pods are never modified,
+// except for the labels used to report the mismatches.
+type RunningPodsScanner struct {
+	BasePlugin `json:",inline"`
+
+	// IntervalMinutes is the interval in minutes between two scans of the running pods.
+	// Defaults to 60.
+	// +optional
+	// +kubebuilder:default=60
+	// +kubebuilder:validation:Minimum:=1
+	IntervalMinutes int32 `json:"intervalMinutes,omitempty"`
+}
+
+// Name returns the name of the RunningPodsScannerPluginName.
+func (b *RunningPodsScanner) Name() string {
+	return RunningPodsScannerPluginName
+}
+
+// Interval returns the interval between two scans of the running pods.
+func (b *RunningPodsScanner) Interval() time.Duration {
+	if b.IntervalMinutes <= 0 {
+		return DefaultRunningPodsScanIntervalMinutes * time.Minute
+	}
+	return time.Duration(b.IntervalMinutes) * time.Minute
+}
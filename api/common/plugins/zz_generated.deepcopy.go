@@ -35,10 +35,46 @@ func (in *BasePlugin) DeepCopy() *BasePlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostAwareScoring) DeepCopyInto(out *CostAwareScoring) {
+	*out = *in
+	out.BasePlugin = in.BasePlugin
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostAwareScoring.
+func (in *CostAwareScoring) DeepCopy() *CostAwareScoring {
+	if in == nil {
+		return nil
+	}
+	out := new(CostAwareScoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENoExecEventRetention) DeepCopyInto(out *ENoExecEventRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENoExecEventRetention.
+func (in *ENoExecEventRetention) DeepCopy() *ENoExecEventRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ENoExecEventRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecFormatErrorMonitor) DeepCopyInto(out *ExecFormatErrorMonitor) {
 	*out = *in
 	out.BasePlugin = in.BasePlugin
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ENoExecEventRetention)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecFormatErrorMonitor.
@@ -59,6 +95,11 @@ func (in *LocalPlugins) DeepCopyInto(out *LocalPlugins) {
 		*out = new(NodeAffinityScoring)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CostAwareScoring != nil {
+		in, out := &in.CostAwareScoring, &out.CostAwareScoring
+		*out = new(CostAwareScoring)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPlugins.
@@ -118,6 +159,16 @@ func (in *Plugins) DeepCopyInto(out *Plugins) {
 	if in.ExecFormatErrorMonitor != nil {
 		in, out := &in.ExecFormatErrorMonitor, &out.ExecFormatErrorMonitor
 		*out = new(ExecFormatErrorMonitor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunningPodsScanner != nil {
+		in, out := &in.RunningPodsScanner, &out.RunningPodsScanner
+		*out = new(RunningPodsScanner)
+		**out = **in
+	}
+	if in.CostAwareScoring != nil {
+		in, out := &in.CostAwareScoring, &out.CostAwareScoring
+		*out = new(CostAwareScoring)
 		**out = **in
 	}
 }
@@ -131,3 +182,19 @@ func (in *Plugins) DeepCopy() *Plugins {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunningPodsScanner) DeepCopyInto(out *RunningPodsScanner) {
+	*out = *in
+	out.BasePlugin = in.BasePlugin
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunningPodsScanner.
+func (in *RunningPodsScanner) DeepCopy() *RunningPodsScanner {
+	if in == nil {
+		return nil
+	}
+	out := new(RunningPodsScanner)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,12 +17,32 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/openshift/multiarch-tuning-operator/api/common"
 	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
 )
 
-const FallbackArchAnnotation = "multiarch.openshift.io/fallback-architecture"
+const (
+	FallbackArchAnnotation = "multiarch.openshift.io/fallback-architecture"
+	ModeAnnotation         = "multiarch.openshift.io/mode"
+	// NoCompatibleNodesPolicyAnnotation preserves the v1beta1 spec.noCompatibleNodesPolicy field.
+	NoCompatibleNodesPolicyAnnotation = "multiarch.openshift.io/no-compatible-nodes-policy"
+	// ArchitectureLabelsAnnotation preserves the v1beta1 spec.architectureLabels field, encoded in JSON.
+	ArchitectureLabelsAnnotation = "multiarch.openshift.io/architecture-labels"
+	// ArchitectureConstraintsPolicyAnnotation preserves the v1beta1 spec.architectureConstraintsPolicy field.
+	ArchitectureConstraintsPolicyAnnotation = "multiarch.openshift.io/architecture-constraints-policy"
+	// SchedulingGateTimeoutMinutesAnnotation preserves the v1beta1 spec.schedulingGateTimeoutMinutes field.
+	SchedulingGateTimeoutMinutesAnnotation = "multiarch.openshift.io/scheduling-gate-timeout-minutes"
+	// NamespaceEnrollmentAnnotation preserves the v1beta1 spec.namespaceEnrollment field.
+	NamespaceEnrollmentAnnotation = "multiarch.openshift.io/namespace-enrollment"
+	// IgnoredNamespacesAnnotation preserves the v1beta1 spec.ignoredNamespaces field, encoded in JSON.
+	IgnoredNamespacesAnnotation = "multiarch.openshift.io/ignored-namespaces"
+)
 
 // ConvertTo converts this ClusterPodPlacementConfig to the Hub version v1beta1.
 func (src *ClusterPodPlacementConfig) ConvertTo(dstRaw conversion.Hub) error {
@@ -34,11 +54,41 @@ func (src *ClusterPodPlacementConfig) ConvertTo(dstRaw conversion.Hub) error {
 	// Spec
 	dst.Spec.LogVerbosity = src.Spec.LogVerbosity
 	dst.Spec.NamespaceSelector = src.Spec.NamespaceSelector
-	// Restore FallbackArchitecture from the annotation, if present.
+	// Restore FallbackArchitecture and Mode from the annotations, if present.
 	// This ensures the value is preserved across API version conversions.
 	if arch, ok := src.Annotations[FallbackArchAnnotation]; ok {
 		dst.Spec.FallbackArchitecture = arch
 	}
+	if mode, ok := src.Annotations[ModeAnnotation]; ok {
+		dst.Spec.Mode = common.PlacementMode(mode)
+	}
+	if policy, ok := src.Annotations[NoCompatibleNodesPolicyAnnotation]; ok {
+		dst.Spec.NoCompatibleNodesPolicy = common.NoCompatibleNodesPolicy(policy)
+	}
+	if labels, ok := src.Annotations[ArchitectureLabelsAnnotation]; ok {
+		dst.Spec.ArchitectureLabels = &multiarchv1beta1.ArchitectureLabels{}
+		if err := json.Unmarshal([]byte(labels), dst.Spec.ArchitectureLabels); err != nil {
+			return fmt.Errorf("unable to decode the %s annotation: %w", ArchitectureLabelsAnnotation, err)
+		}
+	}
+	if policy, ok := src.Annotations[ArchitectureConstraintsPolicyAnnotation]; ok {
+		dst.Spec.ArchitectureConstraintsPolicy = common.ArchitectureConstraintsPolicy(policy)
+	}
+	if timeout, ok := src.Annotations[SchedulingGateTimeoutMinutesAnnotation]; ok {
+		minutes, err := strconv.ParseInt(timeout, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unable to decode the %s annotation: %w", SchedulingGateTimeoutMinutesAnnotation, err)
+		}
+		dst.Spec.SchedulingGateTimeoutMinutes = int32(minutes)
+	}
+	if enrollment, ok := src.Annotations[NamespaceEnrollmentAnnotation]; ok {
+		dst.Spec.NamespaceEnrollment = common.NamespaceEnrollmentMode(enrollment)
+	}
+	if namespaces, ok := src.Annotations[IgnoredNamespacesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(namespaces), &dst.Spec.IgnoredNamespaces); err != nil {
+			return fmt.Errorf("unable to decode the %s annotation: %w", IgnoredNamespacesAnnotation, err)
+		}
+	}
 
 	// Status
 	dst.Status.Conditions = src.Status.Conditions
@@ -58,7 +108,7 @@ func (dst *ClusterPodPlacementConfig) ConvertFrom(srcRaw conversion.Hub) error {
 
 	// ObjectMeta
 	dst.ObjectMeta = src.ObjectMeta
-	// v1alpha1 does not have the FallbackArchitecture field.
+	// v1alpha1 does not have the FallbackArchitecture and Mode fields.
 	// Preserve the value in an annotation to avoid data loss during
 	// v1beta1 -> v1alpha1 -> v1beta1 round-trip conversions.
 	if dst.Annotations == nil {
@@ -69,6 +119,49 @@ func (dst *ClusterPodPlacementConfig) ConvertFrom(srcRaw conversion.Hub) error {
 	} else {
 		delete(dst.Annotations, FallbackArchAnnotation)
 	}
+	if src.Spec.Mode != "" {
+		dst.Annotations[ModeAnnotation] = string(src.Spec.Mode)
+	} else {
+		delete(dst.Annotations, ModeAnnotation)
+	}
+	if src.Spec.NoCompatibleNodesPolicy != "" {
+		dst.Annotations[NoCompatibleNodesPolicyAnnotation] = string(src.Spec.NoCompatibleNodesPolicy)
+	} else {
+		delete(dst.Annotations, NoCompatibleNodesPolicyAnnotation)
+	}
+	if src.Spec.ArchitectureLabels != nil {
+		labels, err := json.Marshal(src.Spec.ArchitectureLabels)
+		if err != nil {
+			return fmt.Errorf("unable to encode the %s annotation: %w", ArchitectureLabelsAnnotation, err)
+		}
+		dst.Annotations[ArchitectureLabelsAnnotation] = string(labels)
+	} else {
+		delete(dst.Annotations, ArchitectureLabelsAnnotation)
+	}
+	if src.Spec.ArchitectureConstraintsPolicy != "" {
+		dst.Annotations[ArchitectureConstraintsPolicyAnnotation] = string(src.Spec.ArchitectureConstraintsPolicy)
+	} else {
+		delete(dst.Annotations, ArchitectureConstraintsPolicyAnnotation)
+	}
+	if src.Spec.SchedulingGateTimeoutMinutes != 0 {
+		dst.Annotations[SchedulingGateTimeoutMinutesAnnotation] = strconv.FormatInt(int64(src.Spec.SchedulingGateTimeoutMinutes), 10)
+	} else {
+		delete(dst.Annotations, SchedulingGateTimeoutMinutesAnnotation)
+	}
+	if src.Spec.NamespaceEnrollment != "" {
+		dst.Annotations[NamespaceEnrollmentAnnotation] = string(src.Spec.NamespaceEnrollment)
+	} else {
+		delete(dst.Annotations, NamespaceEnrollmentAnnotation)
+	}
+	if len(src.Spec.IgnoredNamespaces) > 0 {
+		namespaces, err := json.Marshal(src.Spec.IgnoredNamespaces)
+		if err != nil {
+			return fmt.Errorf("unable to encode the %s annotation: %w", IgnoredNamespacesAnnotation, err)
+		}
+		dst.Annotations[IgnoredNamespacesAnnotation] = string(namespaces)
+	} else {
+		delete(dst.Annotations, IgnoredNamespacesAnnotation)
+	}
 
 	// Spec
 	dst.Spec.LogVerbosity = src.Spec.LogVerbosity
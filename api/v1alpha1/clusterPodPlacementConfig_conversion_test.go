@@ -0,0 +1,187 @@
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
+	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+)
+
+// roundTrip converts the given ClusterPodPlacementConfig to v1alpha1 and back to the hub version.
+func roundTrip(t *testing.T, src *multiarchv1beta1.ClusterPodPlacementConfig) (*ClusterPodPlacementConfig,
+	*multiarchv1beta1.ClusterPodPlacementConfig) {
+	t.Helper()
+	spoke := &ClusterPodPlacementConfig{}
+	if err := spoke.ConvertFrom(src.DeepCopy()); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	hub := &multiarchv1beta1.ClusterPodPlacementConfig{}
+	if err := spoke.DeepCopy().ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	return spoke, hub
+}
+
+func newHubClusterPodPlacementConfig() *multiarchv1beta1.ClusterPodPlacementConfig {
+	return &multiarchv1beta1.ClusterPodPlacementConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: common.SingletonResourceObjectName},
+	}
+}
+
+func TestClusterPodPlacementConfig_ConversionNoCompatibleNodesPolicy(t *testing.T) {
+	src := newHubClusterPodPlacementConfig()
+	src.Spec.NoCompatibleNodesPolicy = common.NoCompatibleNodesPolicyKeepGated
+	spoke, hub := roundTrip(t, src)
+	if got := spoke.Annotations[NoCompatibleNodesPolicyAnnotation]; got != string(common.NoCompatibleNodesPolicyKeepGated) {
+		t.Errorf("annotation %s = %q, want %q", NoCompatibleNodesPolicyAnnotation, got, common.NoCompatibleNodesPolicyKeepGated)
+	}
+	if hub.Spec.NoCompatibleNodesPolicy != common.NoCompatibleNodesPolicyKeepGated {
+		t.Errorf("NoCompatibleNodesPolicy = %q, want %q", hub.Spec.NoCompatibleNodesPolicy, common.NoCompatibleNodesPolicyKeepGated)
+	}
+
+	// Unsetting the field in v1beta1 removes the stale annotation.
+	hub.Spec.NoCompatibleNodesPolicy = ""
+	spoke, hub = roundTrip(t, hub)
+	if _, ok := spoke.Annotations[NoCompatibleNodesPolicyAnnotation]; ok {
+		t.Errorf("annotation %s should be removed", NoCompatibleNodesPolicyAnnotation)
+	}
+	if hub.Spec.NoCompatibleNodesPolicy != "" {
+		t.Errorf("NoCompatibleNodesPolicy = %q, want empty", hub.Spec.NoCompatibleNodesPolicy)
+	}
+}
+
+func TestClusterPodPlacementConfig_ConversionArchitectureLabels(t *testing.T) {
+	src := newHubClusterPodPlacementConfig()
+	src.Spec.ArchitectureLabels = &multiarchv1beta1.ArchitectureLabels{
+		EquivalentKeys: []string{"beta.kubernetes.io/arch", "example.com/arch"},
+		Normalize:      true,
+	}
+	spoke, hub := roundTrip(t, src)
+	if _, ok := spoke.Annotations[ArchitectureLabelsAnnotation]; !ok {
+		t.Errorf("annotation %s should be set", ArchitectureLabelsAnnotation)
+	}
+	if !reflect.DeepEqual(hub.Spec.ArchitectureLabels, src.Spec.ArchitectureLabels) {
+		t.Errorf("ArchitectureLabels = %+v, want %+v", hub.Spec.ArchitectureLabels, src.Spec.ArchitectureLabels)
+	}
+
+	// Unsetting the field in v1beta1 removes the stale annotation.
+	hub.Spec.ArchitectureLabels = nil
+	spoke, hub = roundTrip(t, hub)
+	if _, ok := spoke.Annotations[ArchitectureLabelsAnnotation]; ok {
+		t.Errorf("annotation %s should be removed", ArchitectureLabelsAnnotation)
+	}
+	if hub.Spec.ArchitectureLabels != nil {
+		t.Errorf("ArchitectureLabels = %+v, want nil", hub.Spec.ArchitectureLabels)
+	}
+
+	// A malformed annotation fails the conversion instead of dropping the field silently.
+	spoke.Annotations[ArchitectureLabelsAnnotation] = "{"
+	if err := spoke.ConvertTo(&multiarchv1beta1.ClusterPodPlacementConfig{}); err == nil {
+		t.Errorf("ConvertTo() should fail with a malformed %s annotation", ArchitectureLabelsAnnotation)
+	}
+}
+
+func TestClusterPodPlacementConfig_ConversionArchitectureConstraintsPolicy(t *testing.T) {
+	src := newHubClusterPodPlacementConfig()
+	src.Spec.ArchitectureConstraintsPolicy = common.ArchitectureConstraintsPolicyIntersect
+	spoke, hub := roundTrip(t, src)
+	if got := spoke.Annotations[ArchitectureConstraintsPolicyAnnotation]; got != string(common.ArchitectureConstraintsPolicyIntersect) {
+		t.Errorf("annotation %s = %q, want %q", ArchitectureConstraintsPolicyAnnotation, got,
+			common.ArchitectureConstraintsPolicyIntersect)
+	}
+	if hub.Spec.ArchitectureConstraintsPolicy != common.ArchitectureConstraintsPolicyIntersect {
+		t.Errorf("ArchitectureConstraintsPolicy = %q, want %q", hub.Spec.ArchitectureConstraintsPolicy,
+			common.ArchitectureConstraintsPolicyIntersect)
+	}
+
+	// Unsetting the field in v1beta1 removes the stale annotation.
+	hub.Spec.ArchitectureConstraintsPolicy = ""
+	spoke, hub = roundTrip(t, hub)
+	if _, ok := spoke.Annotations[ArchitectureConstraintsPolicyAnnotation]; ok {
+		t.Errorf("annotation %s should be removed", ArchitectureConstraintsPolicyAnnotation)
+	}
+	if hub.Spec.ArchitectureConstraintsPolicy != "" {
+		t.Errorf("ArchitectureConstraintsPolicy = %q, want empty", hub.Spec.ArchitectureConstraintsPolicy)
+	}
+}
+
+func TestClusterPodPlacementConfig_ConversionSchedulingGateTimeoutMinutes(t *testing.T) {
+	src := newHubClusterPodPlacementConfig()
+	src.Spec.SchedulingGateTimeoutMinutes = 30
+	spoke, hub := roundTrip(t, src)
+	if got := spoke.Annotations[SchedulingGateTimeoutMinutesAnnotation]; got != "30" {
+		t.Errorf("annotation %s = %q, want %q", SchedulingGateTimeoutMinutesAnnotation, got, "30")
+	}
+	if hub.Spec.SchedulingGateTimeoutMinutes != 30 {
+		t.Errorf("SchedulingGateTimeoutMinutes = %d, want 30", hub.Spec.SchedulingGateTimeoutMinutes)
+	}
+
+	// Unsetting the field in v1beta1 removes the stale annotation.
+	hub.Spec.SchedulingGateTimeoutMinutes = 0
+	spoke, hub = roundTrip(t, hub)
+	if _, ok := spoke.Annotations[SchedulingGateTimeoutMinutesAnnotation]; ok {
+		t.Errorf("annotation %s should be removed", SchedulingGateTimeoutMinutesAnnotation)
+	}
+	if hub.Spec.SchedulingGateTimeoutMinutes != 0 {
+		t.Errorf("SchedulingGateTimeoutMinutes = %d, want 0", hub.Spec.SchedulingGateTimeoutMinutes)
+	}
+
+	// A malformed annotation fails the conversion instead of dropping the field silently.
+	spoke.Annotations[SchedulingGateTimeoutMinutesAnnotation] = "thirty"
+	if err := spoke.ConvertTo(&multiarchv1beta1.ClusterPodPlacementConfig{}); err == nil {
+		t.Errorf("ConvertTo() should fail with a malformed %s annotation", SchedulingGateTimeoutMinutesAnnotation)
+	}
+}
+
+func TestClusterPodPlacementConfig_ConversionNamespaceEnrollment(t *testing.T) {
+	src := newHubClusterPodPlacementConfig()
+	src.Spec.NamespaceEnrollment = common.NamespaceEnrollmentModeOptIn
+	spoke, hub := roundTrip(t, src)
+	if got := spoke.Annotations[NamespaceEnrollmentAnnotation]; got != string(common.NamespaceEnrollmentModeOptIn) {
+		t.Errorf("annotation %s = %q, want %q", NamespaceEnrollmentAnnotation, got, common.NamespaceEnrollmentModeOptIn)
+	}
+	if hub.Spec.NamespaceEnrollment != common.NamespaceEnrollmentModeOptIn {
+		t.Errorf("NamespaceEnrollment = %q, want %q", hub.Spec.NamespaceEnrollment, common.NamespaceEnrollmentModeOptIn)
+	}
+
+	// Unsetting the field in v1beta1 removes the stale annotation.
+	hub.Spec.NamespaceEnrollment = ""
+	spoke, hub = roundTrip(t, hub)
+	if _, ok := spoke.Annotations[NamespaceEnrollmentAnnotation]; ok {
+		t.Errorf("annotation %s should be removed", NamespaceEnrollmentAnnotation)
+	}
+	if hub.Spec.NamespaceEnrollment != "" {
+		t.Errorf("NamespaceEnrollment = %q, want empty", hub.Spec.NamespaceEnrollment)
+	}
+}
+
+func TestClusterPodPlacementConfig_ConversionIgnoredNamespaces(t *testing.T) {
+	src := newHubClusterPodPlacementConfig()
+	src.Spec.IgnoredNamespaces = []string{"team-a", "ci-*"}
+	spoke, hub := roundTrip(t, src)
+	if got := spoke.Annotations[IgnoredNamespacesAnnotation]; got != `["team-a","ci-*"]` {
+		t.Errorf("annotation %s = %q, want %q", IgnoredNamespacesAnnotation, got, `["team-a","ci-*"]`)
+	}
+	if !reflect.DeepEqual(hub.Spec.IgnoredNamespaces, src.Spec.IgnoredNamespaces) {
+		t.Errorf("IgnoredNamespaces = %v, want %v", hub.Spec.IgnoredNamespaces, src.Spec.IgnoredNamespaces)
+	}
+
+	// Unsetting the field in v1beta1 removes the stale annotation.
+	hub.Spec.IgnoredNamespaces = nil
+	spoke, hub = roundTrip(t, hub)
+	if _, ok := spoke.Annotations[IgnoredNamespacesAnnotation]; ok {
+		t.Errorf("annotation %s should be removed", IgnoredNamespacesAnnotation)
+	}
+	if hub.Spec.IgnoredNamespaces != nil {
+		t.Errorf("IgnoredNamespaces = %v, want nil", hub.Spec.IgnoredNamespaces)
+	}
+
+	// A malformed annotation fails the conversion instead of dropping the field silently.
+	spoke.Annotations[IgnoredNamespacesAnnotation] = "team-a"
+	if err := spoke.ConvertTo(&multiarchv1beta1.ClusterPodPlacementConfig{}); err == nil {
+		t.Errorf("ConvertTo() should fail with a malformed %s annotation", IgnoredNamespacesAnnotation)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxReportedArchitectureMismatches is the maximum number of mismatches listed in the ArchitectureMismatchReport status.
+const MaxReportedArchitectureMismatches = 100
+
+// ArchitectureMismatchReportSpec defines the desired state of ArchitectureMismatchReport
+type ArchitectureMismatchReportSpec struct {
+}
+
+// ArchitectureMismatch describes a running pod whose images do not support the architecture of the node it runs on.
+type ArchitectureMismatch struct {
+	// PodNamespace is the namespace of the pod.
+	PodNamespace string `json:"podNamespace"`
+
+	// PodName is the name of the pod.
+	PodName string `json:"podName"`
+
+	// NodeName is the name of the node the pod is running on.
+	NodeName string `json:"nodeName"`
+
+	// NodeArchitecture is the value of the kubernetes.io/arch label of the node.
+	NodeArchitecture string `json:"nodeArchitecture"`
+
+	// SupportedArchitectures is the list of architectures supported by all the images of the pod.
+	// +optional
+	SupportedArchitectures []string `json:"supportedArchitectures,omitempty"`
+}
+
+// ArchitectureMismatchReportStatus defines the observed state of ArchitectureMismatchReport
+type ArchitectureMismatchReportStatus struct {
+	// LastScanTime is the time the last scan of the running pods completed.
+	// +optional
+	LastScanTime *metav1.Time `json:"lastScanTime,omitempty"`
+
+	// ScannedPods is the number of running pods whose images were inspected in the last scan.
+	ScannedPods int32 `json:"scannedPods"`
+
+	// MismatchedPods is the number of running pods found in the last scan on a node whose architecture
+	// is not supported by their images.
+	MismatchedPods int32 `json:"mismatchedPods"`
+
+	// Mismatches lists the running pods found in the last scan on a node whose architecture is not supported by
+	// their images. The list is truncated to the first 100 entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Mismatches []ArchitectureMismatch `json:"mismatches,omitempty"`
+}
+
+// ArchitectureMismatchReport summarizes the running pods scheduled on nodes whose architecture is not supported by
+// their images. It is maintained by the pod placement controller when the RunningPodsScanner plugin is enabled in the
+// ClusterPodPlacementConfig. The operator manages a single object named "cluster".
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=architecturemismatchreports,scope=Cluster
+// +kubebuilder:printcolumn:name=Scanned,JSONPath=.status.scannedPods,type=integer
+// +kubebuilder:printcolumn:name=Mismatched,JSONPath=.status.mismatchedPods,type=integer
+// +kubebuilder:printcolumn:name=LastScan,JSONPath=.status.lastScanTime,type=date
+type ArchitectureMismatchReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArchitectureMismatchReportSpec   `json:"spec,omitempty"`
+	Status ArchitectureMismatchReportStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ArchitectureMismatchReportList contains a list of ArchitectureMismatchReport
+type ArchitectureMismatchReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArchitectureMismatchReport `json:"items"`
+}
@@ -18,14 +18,18 @@ package v1beta1
 
 import (
 	"fmt"
+	"path"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/openshift/library-go/pkg/operator/v1helpers"
 
 	"github.com/openshift/multiarch-tuning-operator/api/common"
 	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
 )
 
 // ClusterPodPlacementConfigSpec defines the desired state of ClusterPodPlacementConfig
@@ -44,6 +48,27 @@ type ClusterPodPlacementConfigSpec struct {
 	// +optional
 	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 
+	// NamespaceEnrollment defines how the namespaces are enrolled in the pod placement operand.
+	// Valid values are: "OptOut", "OptIn".
+	// With OptOut, all the namespaces selected by the namespaceSelector are enrolled.
+	// With OptIn, only the namespaces labeled with multiarch.openshift.io/include-pod-placement are enrolled, if they
+	// are also selected by the namespaceSelector. It allows to roll out the pod placement operand gradually.
+	// Defaults to "OptOut".
+	// +optional
+	// +kubebuilder:default=OptOut
+	NamespaceEnrollment common.NamespaceEnrollmentMode `json:"namespaceEnrollment,omitempty"`
+
+	// IgnoredNamespaces is the list of namespaces whose pods are ignored by the pod placement operand, in addition to
+	// the operator's namespace and the kube- prefixed ones. The entries are namespace names or glob patterns,
+	// such as openshift-* or *-system, following the syntax of the Go path.Match function.
+	// The names are excluded by the namespaceSelector of the pod placement webhook; the pods of the namespaces matching
+	// a glob pattern still reach the webhook, which ignores them without setting the scheduling gate.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	IgnoredNamespaces []string `json:"ignoredNamespaces,omitempty"`
+
 	// Plugins defines the configurable plugins for this component.
 	// This field is optional and will be omitted from the output if not set.
 	// +optional
@@ -56,6 +81,76 @@ type ClusterPodPlacementConfigSpec struct {
 	// +kubebuilder:default=""
 	// +kubebuilder:validation:Enum=arm64;amd64;ppc64le;s390x;""
 	FallbackArchitecture string `json:"fallbackArchitecture,omitempty"`
+
+	// Mode defines how the pod placement operand acts on the pods.
+	// Valid values are: "Enforce", "Audit".
+	// In Enforce mode, the pods are gated and their node affinity is set according to the images' architectures.
+	// In Audit mode, the pods are not gated and their scheduling constraints are not modified: the pod placement
+	// controller inspects them asynchronously and only records the node affinity that would have been set.
+	// Defaults to "Enforce".
+	// +optional
+	// +kubebuilder:default=Enforce
+	Mode common.PlacementMode `json:"mode,omitempty"`
+
+	// NoCompatibleNodesPolicy defines how the pod placement controller handles the pods whose images support none of
+	// the architectures of the ready and schedulable nodes. In any case, the pod gets the
+	// multiarch.openshift.io/no-compatible-nodes label and an event reporting the architectures supported by its images.
+	// Valid values are: "Ungate", "KeepGated", "Fallback".
+	// With Ungate, the node affinity is set to the architectures supported by the images and the scheduling gate is
+	// removed: the pod stays pending until a compatible node joins the cluster.
+	// With KeepGated, the pod keeps the scheduling gate and is left unchanged until a compatible node joins the cluster.
+	// With Fallback, the node affinity is set to the fallbackArchitecture and the scheduling gate is removed.
+	// The Fallback policy requires the fallbackArchitecture to be set.
+	// Defaults to "Ungate".
+	// +optional
+	// +kubebuilder:default=Ungate
+	NoCompatibleNodesPolicy common.NoCompatibleNodesPolicy `json:"noCompatibleNodesPolicy,omitempty"`
+
+	// ArchitectureConstraintsPolicy defines how the pod placement controller handles the pods whose nodeSelector or
+	// required node affinity already constrain the architecture of the nodes.
+	// Valid values are: "Skip", "Intersect".
+	// With Skip, the architecture constraints set by the user are left unchanged.
+	// With Intersect, the architectures allowed by the user are narrowed to those supported by the images of the pod,
+	// and a warning event is published if the user allows architectures the images cannot run on.
+	// Defaults to "Skip".
+	// +optional
+	// +kubebuilder:default=Skip
+	ArchitectureConstraintsPolicy common.ArchitectureConstraintsPolicy `json:"architectureConstraintsPolicy,omitempty"`
+
+	// SchedulingGateTimeoutMinutes is the time in minutes after which the operator removes the scheduling gate from the
+	// pods that are still gated, for example because the pod placement controller is down. The ungated pods are not
+	// constrained to the architectures of their images and are labeled with
+	// multiarch.openshift.io/scheduling-gate=timed-out.
+	// The pods kept gated by the KeepGated NoCompatibleNodesPolicy are not ungated by the timeout.
+	// The timeout is disabled if not set or set to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	SchedulingGateTimeoutMinutes int32 `json:"schedulingGateTimeoutMinutes,omitempty"`
+
+	// ArchitectureLabels defines the node label keys, other than kubernetes.io/arch, whose values are architecture
+	// names, and whether the pod constraints on them are normalized to the kubernetes.io/arch label.
+	// +optional
+	ArchitectureLabels *ArchitectureLabels `json:"architectureLabels,omitempty"`
+}
+
+// ArchitectureLabels defines the node label keys equivalent to kubernetes.io/arch.
+type ArchitectureLabels struct {
+	// EquivalentKeys is the list of node label keys whose values are architecture names, such as
+	// beta.kubernetes.io/arch. The pods constraining one of these keys in their nodeSelector or node affinity are
+	// handled as if they constrained the kubernetes.io/arch label: the constraints are considered set by the user.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	EquivalentKeys []string `json:"equivalentKeys,omitempty"`
+
+	// Normalize defines whether the pod placement webhook rewrites, at admission, the nodeSelector entries and the
+	// node affinity expressions of the pods on the equivalent keys to use the kubernetes.io/arch label instead.
+	// Only the pods gated by the operator are normalized: the ignored pods and the pods admitted in Audit mode are
+	// left unchanged.
+	// Defaults to false.
+	// +optional
+	Normalize bool `json:"normalize,omitempty"`
 }
 
 // ClusterPodPlacementConfigStatus defines the observed state of ClusterPodPlacementConfig
@@ -63,6 +158,16 @@ type ClusterPodPlacementConfigStatus struct {
 	// Conditions represents the latest available observations of a ClusterPodPlacementConfig's current state.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// EnrolledNamespaces is the number of namespaces enrolled in the pod placement operand, according to the
+	// namespaceEnrollment mode and the namespaceSelector. The namespace of the operator and the kube- prefixed
+	// namespaces are never enrolled. It is always reported, as no enrolled namespace is a meaningful state.
+	// +optional
+	EnrolledNamespaces int32 `json:"enrolledNamespaces"`
+
+	// TotalNamespaces is the number of namespaces in the cluster.
+	// +optional
+	TotalNamespaces int32 `json:"totalNamespaces,omitempty"`
+
 	// The following fields are used to derive the conditions. They are not exposed to the user.
 	//nolint:revive // controller-gen requires json tags on all fields, even unexported ones
 	available                                bool `json:"-"`
@@ -215,6 +320,7 @@ func (s *ClusterPodPlacementConfigStatus) buildConditions() {
 // +kubebuilder:printcolumn:name=Degraded,JSONPath=.status.conditions[?(@.type=="Degraded")].status,type=string
 // +kubebuilder:printcolumn:name=Since,JSONPath=.status.conditions[?(@.type=="Progressing")].lastTransitionTime,type=date
 // +kubebuilder:printcolumn:name=Status,JSONPath=.status.conditions[?(@.type=="Available")].reason,type=string
+// +kubebuilder:printcolumn:name=Enrolled,JSONPath=.status.enrolledNamespaces,type=integer,priority=1
 type ClusterPodPlacementConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -230,6 +336,84 @@ func (c *ClusterPodPlacementConfig) PluginsEnabled(plugin common.Plugin) bool {
 	return false
 }
 
+// GetNoCompatibleNodesPolicy returns the policy applied to the pods whose images support none of the architectures of
+// the ready and schedulable nodes, defaulting to Ungate.
+func (c *ClusterPodPlacementConfig) GetNoCompatibleNodesPolicy() common.NoCompatibleNodesPolicy {
+	if c == nil || c.Spec.NoCompatibleNodesPolicy == "" {
+		return common.NoCompatibleNodesPolicyUngate
+	}
+	return c.Spec.NoCompatibleNodesPolicy
+}
+
+// EnrolledNamespaceSelector returns the namespace selector of the namespaces enrolled in the pod placement operand.
+// In OptIn mode, the namespaceSelector is restricted to the namespaces labeled with
+// multiarch.openshift.io/include-pod-placement.
+func (c *ClusterPodPlacementConfig) EnrolledNamespaceSelector() *metav1.LabelSelector {
+	if c.Spec.NamespaceEnrollment != common.NamespaceEnrollmentModeOptIn {
+		return c.Spec.NamespaceSelector
+	}
+	selector := &metav1.LabelSelector{}
+	if c.Spec.NamespaceSelector != nil {
+		selector = c.Spec.NamespaceSelector.DeepCopy()
+	}
+	selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      utils.NamespaceOptInLabel,
+		Operator: metav1.LabelSelectorOpExists,
+	})
+	return selector
+}
+
+// IsNamespaceIgnored returns true if the namespace matches one of the ignoredNamespaces names or patterns.
+func (c *ClusterPodPlacementConfig) IsNamespaceIgnored(namespace string) bool {
+	if c == nil {
+		return false
+	}
+	for _, pattern := range c.Spec.IgnoredNamespaces {
+		// The patterns are validated by the ClusterPodPlacementConfig webhook: a malformed pattern matches nothing.
+		if matched, err := path.Match(pattern, namespace); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// SchedulingGateTimeout returns the time after which the scheduling gate is removed from the pods still gated. It is
+// 0 if the timeout is disabled.
+func (c *ClusterPodPlacementConfig) SchedulingGateTimeout() time.Duration {
+	if c == nil || c.Spec.SchedulingGateTimeoutMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(c.Spec.SchedulingGateTimeoutMinutes) * time.Minute
+}
+
+// IntersectArchitectureConstraints returns true if the architecture constraints set by the user have to be narrowed
+// to the architectures supported by the images of the pods.
+func (c *ClusterPodPlacementConfig) IntersectArchitectureConstraints() bool {
+	return c != nil && c.Spec.ArchitectureConstraintsPolicy == common.ArchitectureConstraintsPolicyIntersect
+}
+
+// ArchitectureLabelKeys returns the node label keys whose values are architecture names: kubernetes.io/arch and the
+// equivalent keys set in the ArchitectureLabels.
+func (c *ClusterPodPlacementConfig) ArchitectureLabelKeys() sets.Set[string] {
+	keys := sets.New(utils.ArchLabel)
+	if c != nil && c.Spec.ArchitectureLabels != nil {
+		keys.Insert(c.Spec.ArchitectureLabels.EquivalentKeys...)
+	}
+	return keys
+}
+
+// NormalizeArchitectureLabels returns true if the constraints on the equivalent architecture label keys have to be
+// rewritten to use the kubernetes.io/arch label.
+func (c *ClusterPodPlacementConfig) NormalizeArchitectureLabels() bool {
+	return c != nil && c.Spec.ArchitectureLabels != nil && c.Spec.ArchitectureLabels.Normalize &&
+		len(c.Spec.ArchitectureLabels.EquivalentKeys) > 0
+}
+
+// IsAuditMode returns true if the pod placement operand should only audit the pods, without gating them.
+func (c *ClusterPodPlacementConfig) IsAuditMode() bool {
+	return c != nil && c.Spec.Mode == common.PlacementModeAudit
+}
+
 //+kubebuilder:object:root=true
 
 // ClusterPodPlacementConfigList contains a list of ClusterPodPlacementConfig
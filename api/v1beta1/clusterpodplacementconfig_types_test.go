@@ -1,9 +1,14 @@
 package v1beta1
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
 )
 
 func Test_conditionFromBool(t *testing.T) {
@@ -247,3 +252,259 @@ func TestClusterPodPlacementConfigStatus_Build(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterPodPlacementConfig_IsAuditMode(t *testing.T) {
+	tests := []struct {
+		name string
+		cppc *ClusterPodPlacementConfig
+		want bool
+	}{
+		{
+			name: "nil ClusterPodPlacementConfig",
+			cppc: nil,
+			want: false,
+		},
+		{
+			name: "mode not set",
+			cppc: &ClusterPodPlacementConfig{},
+			want: false,
+		},
+		{
+			name: "Enforce mode",
+			cppc: &ClusterPodPlacementConfig{Spec: ClusterPodPlacementConfigSpec{Mode: common.PlacementModeEnforce}},
+			want: false,
+		},
+		{
+			name: "Audit mode",
+			cppc: &ClusterPodPlacementConfig{Spec: ClusterPodPlacementConfigSpec{Mode: common.PlacementModeAudit}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cppc.IsAuditMode(); got != tt.want {
+				t.Errorf("IsAuditMode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterPodPlacementConfig_GetNoCompatibleNodesPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		cppc *ClusterPodPlacementConfig
+		want common.NoCompatibleNodesPolicy
+	}{
+		{
+			name: "nil ClusterPodPlacementConfig",
+			cppc: nil,
+			want: common.NoCompatibleNodesPolicyUngate,
+		},
+		{
+			name: "policy not set",
+			cppc: &ClusterPodPlacementConfig{},
+			want: common.NoCompatibleNodesPolicyUngate,
+		},
+		{
+			name: "KeepGated policy",
+			cppc: &ClusterPodPlacementConfig{Spec: ClusterPodPlacementConfigSpec{
+				NoCompatibleNodesPolicy: common.NoCompatibleNodesPolicyKeepGated}},
+			want: common.NoCompatibleNodesPolicyKeepGated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cppc.GetNoCompatibleNodesPolicy(); got != tt.want {
+				t.Errorf("GetNoCompatibleNodesPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterPodPlacementConfigValidator_validateNoCompatibleNodesPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ClusterPodPlacementConfigSpec
+		wantErr bool
+	}{
+		{
+			name:    "Fallback policy without fallback architecture",
+			spec:    ClusterPodPlacementConfigSpec{NoCompatibleNodesPolicy: common.NoCompatibleNodesPolicyFallback},
+			wantErr: true,
+		},
+		{
+			name: "Fallback policy with fallback architecture",
+			spec: ClusterPodPlacementConfigSpec{NoCompatibleNodesPolicy: common.NoCompatibleNodesPolicyFallback,
+				FallbackArchitecture: "amd64"},
+			wantErr: false,
+		},
+		{
+			name:    "KeepGated policy without fallback architecture",
+			spec:    ClusterPodPlacementConfigSpec{NoCompatibleNodesPolicy: common.NoCompatibleNodesPolicyKeepGated},
+			wantErr: false,
+		},
+		{
+			name:    "valid ignored namespaces patterns",
+			spec:    ClusterPodPlacementConfigSpec{IgnoredNamespaces: []string{"istio-system", "openshift-*"}},
+			wantErr: false,
+		},
+		{
+			name:    "malformed ignored namespaces pattern",
+			spec:    ClusterPodPlacementConfigSpec{IgnoredNamespaces: []string{"openshift-["}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &ClusterPodPlacementConfigValidator{}
+			if _, err := v.validate(&ClusterPodPlacementConfig{Spec: tt.spec}); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClusterPodPlacementConfig_ArchitectureLabelKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		cppc          *ClusterPodPlacementConfig
+		want          []string
+		wantNormalize bool
+	}{
+		{
+			name: "nil ClusterPodPlacementConfig",
+			cppc: nil,
+			want: []string{"kubernetes.io/arch"},
+		},
+		{
+			name: "no equivalent keys",
+			cppc: &ClusterPodPlacementConfig{Spec: ClusterPodPlacementConfigSpec{
+				ArchitectureLabels: &ArchitectureLabels{Normalize: true}}},
+			want: []string{"kubernetes.io/arch"},
+		},
+		{
+			name: "equivalent keys with normalization",
+			cppc: &ClusterPodPlacementConfig{Spec: ClusterPodPlacementConfigSpec{
+				ArchitectureLabels: &ArchitectureLabels{EquivalentKeys: []string{"beta.kubernetes.io/arch"}, Normalize: true}}},
+			want:          []string{"beta.kubernetes.io/arch", "kubernetes.io/arch"},
+			wantNormalize: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sets.List(tt.cppc.ArchitectureLabelKeys()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ArchitectureLabelKeys() = %v, want %v", got, tt.want)
+			}
+			if got := tt.cppc.NormalizeArchitectureLabels(); got != tt.wantNormalize {
+				t.Errorf("NormalizeArchitectureLabels() = %v, want %v", got, tt.wantNormalize)
+			}
+		})
+	}
+}
+
+func TestClusterPodPlacementConfig_SchedulingGateTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		cppc *ClusterPodPlacementConfig
+		want time.Duration
+	}{
+		{
+			name: "nil ClusterPodPlacementConfig",
+			cppc: nil,
+			want: 0,
+		},
+		{
+			name: "timeout not set",
+			cppc: &ClusterPodPlacementConfig{},
+			want: 0,
+		},
+		{
+			name: "timeout set",
+			cppc: &ClusterPodPlacementConfig{Spec: ClusterPodPlacementConfigSpec{SchedulingGateTimeoutMinutes: 15}},
+			want: 15 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cppc.SchedulingGateTimeout(); got != tt.want {
+				t.Errorf("SchedulingGateTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterPodPlacementConfig_EnrolledNamespaceSelector(t *testing.T) {
+	optIn := v1.LabelSelectorRequirement{Key: "multiarch.openshift.io/include-pod-placement", Operator: v1.LabelSelectorOpExists}
+	excluded := v1.LabelSelectorRequirement{Key: "multiarch.openshift.io/exclude-pod-placement", Operator: v1.LabelSelectorOpDoesNotExist}
+	tests := []struct {
+		name string
+		spec ClusterPodPlacementConfigSpec
+		want *v1.LabelSelector
+	}{
+		{
+			name: "OptOut without namespaceSelector",
+			spec: ClusterPodPlacementConfigSpec{},
+			want: nil,
+		},
+		{
+			name: "OptOut with namespaceSelector",
+			spec: ClusterPodPlacementConfigSpec{
+				NamespaceSelector: &v1.LabelSelector{MatchExpressions: []v1.LabelSelectorRequirement{excluded}},
+			},
+			want: &v1.LabelSelector{MatchExpressions: []v1.LabelSelectorRequirement{excluded}},
+		},
+		{
+			name: "OptIn without namespaceSelector",
+			spec: ClusterPodPlacementConfigSpec{NamespaceEnrollment: common.NamespaceEnrollmentModeOptIn},
+			want: &v1.LabelSelector{MatchExpressions: []v1.LabelSelectorRequirement{optIn}},
+		},
+		{
+			name: "OptIn with namespaceSelector",
+			spec: ClusterPodPlacementConfigSpec{
+				NamespaceEnrollment: common.NamespaceEnrollmentModeOptIn,
+				NamespaceSelector: &v1.LabelSelector{MatchLabels: map[string]string{"team": "a"},
+					MatchExpressions: []v1.LabelSelectorRequirement{excluded}},
+			},
+			want: &v1.LabelSelector{MatchLabels: map[string]string{"team": "a"},
+				MatchExpressions: []v1.LabelSelectorRequirement{excluded, optIn}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ClusterPodPlacementConfig{Spec: tt.spec}
+			if got := c.EnrolledNamespaceSelector(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnrolledNamespaceSelector() = %v, want %v", got, tt.want)
+			}
+			if tt.spec.NamespaceSelector != nil && len(tt.spec.NamespaceSelector.MatchExpressions) != 1 {
+				t.Errorf("EnrolledNamespaceSelector() modified the namespaceSelector")
+			}
+		})
+	}
+}
+
+func TestClusterPodPlacementConfig_IsNamespaceIgnored(t *testing.T) {
+	cppc := &ClusterPodPlacementConfig{Spec: ClusterPodPlacementConfigSpec{
+		IgnoredNamespaces: []string{"istio-system", "openshift-*", "*-infra", "bad-["},
+	}}
+	tests := []struct {
+		name      string
+		cppc      *ClusterPodPlacementConfig
+		namespace string
+		want      bool
+	}{
+		{name: "nil cppc", cppc: nil, namespace: "istio-system", want: false},
+		{name: "no ignored namespaces", cppc: &ClusterPodPlacementConfig{}, namespace: "istio-system", want: false},
+		{name: "exact name", cppc: cppc, namespace: "istio-system", want: true},
+		{name: "prefix pattern", cppc: cppc, namespace: "openshift-monitoring", want: true},
+		{name: "suffix pattern", cppc: cppc, namespace: "team-infra", want: true},
+		{name: "not matching", cppc: cppc, namespace: "istio-system-2", want: false},
+		{name: "malformed pattern", cppc: cppc, namespace: "bad-[", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cppc.IsNamespaceIgnored(tt.namespace); got != tt.want {
+				t.Errorf("IsNamespaceIgnored(%q) = %v, want %v", tt.namespace, got, tt.want)
+			}
+		})
+	}
+}
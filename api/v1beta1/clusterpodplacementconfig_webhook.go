@@ -20,10 +20,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
 )
 
 // +kubebuilder:webhook:path=/validate-multiarch-openshift-io-v1beta1-clusterpodplacementconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=multiarch.openshift.io,resources=clusterpodplacementconfigs,verbs=create;update;delete,versions=v1beta1,name=validate-clusterpodplacementconfig.multiarch.openshift.io,admissionReviewVersions=v1
@@ -63,6 +66,14 @@ func (v *ClusterPodPlacementConfigValidator) ValidateDelete(ctx context.Context,
 }
 
 func (v *ClusterPodPlacementConfigValidator) validate(cppc *ClusterPodPlacementConfig) (warnings admission.Warnings, err error) {
+	if cppc.Spec.NoCompatibleNodesPolicy == common.NoCompatibleNodesPolicyFallback && cppc.Spec.FallbackArchitecture == "" {
+		return nil, errors.New("the .spec.fallbackArchitecture must be set when the .spec.noCompatibleNodesPolicy is Fallback")
+	}
+	for _, pattern := range cppc.Spec.IgnoredNamespaces {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q in the .spec.ignoredNamespaces list: %w", pattern, err)
+		}
+	}
 	if cppc.Spec.Plugins == nil || cppc.Spec.Plugins.NodeAffinityScoring == nil {
 		return nil, nil
 	}
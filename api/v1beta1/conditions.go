@@ -22,3 +22,22 @@ const (
 		"scheduling gate. The pod placement controller is updating them and will terminate."
 	AllComponentsReady = "AllComponentsReady"
 )
+
+const (
+	ValidType   = "Valid"
+	AppliedType = "Applied"
+
+	ValidConfigurationReason    = "ValidConfiguration"
+	InvalidLabelSelectorReason  = "InvalidLabelSelector"
+	NoPluginEnabledReason       = "NoPluginEnabled"
+	PreferencesAppliedReason    = "PreferencesApplied"
+	PreferencesNotAppliedReason = "PreferencesNotApplied"
+	NoMatchingPodsReason        = "NoMatchingPods"
+
+	ValidConfigurationMsg   = "The pod placement config is valid."
+	InvalidLabelSelectorMsg = "The label selector is invalid: %s."
+	NoPluginEnabledMsg      = "No plugin is enabled. The pod placement config has no effect on the pods."
+	AppliedMsg              = "The preferences have been applied to %d of the %d non-terminated pods selected by the label selector."
+	OverlappingSelectorsMsg = "The label selector overlaps with the one of the pod placement config %q on %d pods."
+	PriorityShadowingMsg    = "The preferences are shadowed on %d pods by the higher priority pod placement config %q."
+)
@@ -25,6 +25,19 @@ type ENoExecEventSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of the object
 }
 
+// ENoExecEventOutcome is the outcome of the reconciliation of an ENoExecEvent by the ENoExecEvent handler.
+// +kubebuilder:validation:Enum=Published;Failed
+type ENoExecEventOutcome string
+
+const (
+	// ENoExecEventOutcomePublished is the outcome of the ENoExecEvents published as events of their pod, or node,
+	// by the ENoExecEvent handler.
+	ENoExecEventOutcomePublished ENoExecEventOutcome = "Published"
+	// ENoExecEventOutcomeFailed is the outcome of the ENoExecEvents the ENoExecEvent handler could not publish, e.g.,
+	// because their pod was deleted.
+	ENoExecEventOutcomeFailed ENoExecEventOutcome = "Failed"
+)
+
 // ENoExecEventStatus defines the observed state of ENoExecEvent
 type ENoExecEventStatus struct {
 	// For validating the fields of NodeName and PodName we mimic the functionality of IsDNS1123Subdomain (https://github.com/kubernetes/kubernetes/blob/5be5fd022920e0aa77e29792fffbb5f3690547b3/staging/src/k8s.io/apimachinery/pkg/util/validation/validation.go#L219)
@@ -66,6 +79,83 @@ type ENoExecEventStatus struct {
 	//      https://github.com/elastic/apm/blob/c7655441bb5f15db5ddbd7f4b60cb0735758d44d/specs/agents/metadata.md?plain=1#L111
 	// +kubebuilder:validation:Pattern=`^.+://[a-f0-9]{64}$`
 	ContainerID string `json:"containerID,omitempty"`
+
+	// Executable is the path of the file whose execution failed with ENOEXEC, as passed to the execve syscall.
+	// It is truncated to 255 characters.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Executable string `json:"executable,omitempty"`
+
+	// ExecutableArchitecture is the architecture of the executable, read from the e_machine field of its ELF header.
+	// It is empty if the executable is not an ELF binary or could not be read.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	ExecutableArchitecture string `json:"executableArchitecture,omitempty"`
+
+	// CgroupPath is the cgroup path of the process whose exec failed, for the node-scoped events of the processes that
+	// do not run in a pod. It is empty for the events of the pods.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	CgroupPath string `json:"cgroupPath,omitempty"`
+
+	// Command is the command line of the process whose exec failed, for the node-scoped events of the processes that
+	// do not run in a pod. It is truncated to 255 characters.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Command string `json:"command,omitempty"`
+
+	// Count is the number of exec format errors of the same pod container, or host cgroup, aggregated in this event by
+	// the ENoExecEvent daemon. The restarts of a container are aggregated in the same event, with the ID of the last
+	// container.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count int32 `json:"count,omitempty"`
+
+	// FirstSeen is the time the first of the aggregated exec format errors was detected.
+	// +optional
+	FirstSeen *metav1.Time `json:"firstSeen,omitempty"`
+
+	// LastSeen is the time the last of the aggregated exec format errors was detected.
+	// +optional
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
+
+	// The following fields are set by the ENoExecEvent handler when the ENoExecEvents are retained after their
+	// reconciliation. The ENoExecEvent daemon does not update the reconciled ENoExecEvents: the exec format errors
+	// detected after the reconciliation are aggregated in a new ENoExecEvent.
+
+	// Outcome is the outcome of the reconciliation of the ENoExecEvent by the ENoExecEvent handler.
+	// +optional
+	Outcome ENoExecEventOutcome `json:"outcome,omitempty"`
+
+	// Reason is the reason of the Failed outcome, e.g., pod-not-found.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Reason string `json:"reason,omitempty"`
+
+	// ContainerName is the name of the container of the pod, resolved by the ENoExecEvent handler from the
+	// ContainerID.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	ContainerName string `json:"containerName,omitempty"`
+
+	// NodeArchitecture is the architecture of the node, as read by the ENoExecEvent handler.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	NodeArchitecture string `json:"nodeArchitecture,omitempty"`
+
+	// HandledAt is the time the ENoExecEvent handler reconciled the ENoExecEvent.
+	// +optional
+	HandledAt *metav1.Time `json:"handledAt,omitempty"`
+}
+
+// IsNodeScoped returns true if the event was raised by a process that does not run in a pod.
+func (s *ENoExecEventStatus) IsNodeScoped() bool {
+	return s.PodName == "" && s.PodNamespace == "" && s.ContainerID == "" && s.CgroupPath != ""
+}
+
+// IsHandled returns true if the ENoExecEvent handler reconciled the event and recorded its outcome.
+func (s *ENoExecEventStatus) IsHandled() bool {
+	return s.HandledAt != nil
 }
 
 //+kubebuilder:object:root=true
@@ -76,6 +166,14 @@ type ENoExecEventStatus struct {
 // +kubebuilder:printcolumn:name=PodName,JSONPath=.status.podName,type=string
 // +kubebuilder:printcolumn:name=PodNamespace,JSONPath=.status.podNamespace,type=string
 // +kubebuilder:printcolumn:name=ContainerID,JSONPath=.status.containerID,type=string
+// +kubebuilder:printcolumn:name=Executable,JSONPath=.status.executable,type=string,priority=1
+// +kubebuilder:printcolumn:name=ExecutableArchitecture,JSONPath=.status.executableArchitecture,type=string,priority=1
+// +kubebuilder:printcolumn:name=CgroupPath,JSONPath=.status.cgroupPath,type=string,priority=1
+// +kubebuilder:printcolumn:name=Count,JSONPath=.status.count,type=integer
+// +kubebuilder:printcolumn:name=LastSeen,JSONPath=.status.lastSeen,type=date
+// +kubebuilder:printcolumn:name=Outcome,JSONPath=.status.outcome,type=string
+// +kubebuilder:printcolumn:name=ContainerName,JSONPath=.status.containerName,type=string,priority=1
+// +kubebuilder:printcolumn:name=HandledAt,JSONPath=.status.handledAt,type=date,priority=1
 type ENoExecEvent struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ExecFormatErrorReportName is the name of the ExecFormatErrorReport object of each namespace.
+	ExecFormatErrorReportName = "exec-format-errors"
+	// MaxReportedExecFormatErrorWorkloads is the maximum number of workloads listed in the ExecFormatErrorReport
+	// status.
+	MaxReportedExecFormatErrorWorkloads = 100
+	// MaxReportedExecFormatErrorContainers is the maximum number of containers listed for each workload in the
+	// ExecFormatErrorReport status.
+	MaxReportedExecFormatErrorContainers = 10
+)
+
+// ExecFormatErrorReportSpec defines the desired state of ExecFormatErrorReport
+type ExecFormatErrorReportSpec struct {
+}
+
+// ExecFormatErrorContainer describes the exec format errors of a container of a workload, aggregated by the
+// ENoExecEvent daemon from FirstSeen to LastSeen.
+type ExecFormatErrorContainer struct {
+	// Name is the name of the container.
+	Name string `json:"name"`
+
+	// ContainerID is the ID of the container instance, in the format <runtime>://<id>.
+	ContainerID string `json:"containerID"`
+
+	// Count is the number of exec format errors of the container instance from FirstSeen to LastSeen.
+	Count int32 `json:"count"`
+
+	// FirstSeen is the time the first of the exec format errors was detected.
+	// +optional
+	FirstSeen *metav1.Time `json:"firstSeen,omitempty"`
+
+	// LastSeen is the time the last of the exec format errors was detected.
+	// +optional
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
+}
+
+// ExecFormatErrorWorkload summarizes the exec format errors of the pods of a workload.
+type ExecFormatErrorWorkload struct {
+	// Kind is the kind of the workload owning the pods, e.g., Deployment, or Pod for the pods without a controller.
+	Kind string `json:"kind"`
+
+	// Name is the name of the workload.
+	Name string `json:"name"`
+
+	// Count is the number of exec format errors of the pods of the workload.
+	Count int32 `json:"count"`
+
+	// NodeArchitectures is the list of the architectures of the nodes the exec format errors were detected on.
+	// +optional
+	NodeArchitectures []string `json:"nodeArchitectures,omitempty"`
+
+	// Containers lists the containers failing with an exec format error, the most recent first. The list is
+	// truncated to the first 10 entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	Containers []ExecFormatErrorContainer `json:"containers,omitempty"`
+
+	// LastSeen is the time the last exec format error of the workload was detected.
+	// +optional
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
+}
+
+// ExecFormatErrorReportStatus defines the observed state of ExecFormatErrorReport
+type ExecFormatErrorReportStatus struct {
+	// Count is the number of exec format errors of the pods of the namespace.
+	Count int32 `json:"count"`
+
+	// LastSeen is the time the last exec format error of the namespace was detected.
+	// +optional
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
+
+	// Workloads lists the workloads whose pods failed with an exec format error, the most recent first. The list is
+	// truncated to the first 100 entries.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Workloads []ExecFormatErrorWorkload `json:"workloads,omitempty"`
+}
+
+// ExecFormatErrorReport summarizes, per workload, the exec format errors of the pods of its namespace. It is
+// maintained by the ENoExecEvent handler when the ExecFormatErrorMonitor plugin is enabled in the
+// ClusterPodPlacementConfig, and can be read by the users allowed to view the namespace. The handler manages a single
+// object named "exec-format-errors" in each namespace with exec format errors.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=execformaterrorreports,scope=Namespaced
+// +kubebuilder:printcolumn:name=Count,JSONPath=.status.count,type=integer
+// +kubebuilder:printcolumn:name=LastSeen,JSONPath=.status.lastSeen,type=date
+type ExecFormatErrorReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExecFormatErrorReportSpec   `json:"spec,omitempty"`
+	Status ExecFormatErrorReportStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ExecFormatErrorReportList contains a list of ExecFormatErrorReport
+type ExecFormatErrorReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExecFormatErrorReport `json:"items"`
+}
@@ -41,6 +41,8 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&ClusterPodPlacementConfig{}, &ClusterPodPlacementConfigList{},
 		&PodPlacementConfig{}, &PodPlacementConfigList{},
 		&ENoExecEvent{}, &ENoExecEventList{},
+		&ArchitectureMismatchReport{}, &ArchitectureMismatchReportList{},
+		&ExecFormatErrorReport{}, &ExecFormatErrorReportList{},
 	)
 	metav1.AddToGroupVersion(s, GroupVersion)
 	return nil
@@ -52,3 +54,7 @@ const PodPlacementConfigResource = "podplacementconfigs"
 const PodPlacementConfigKind = "PodPlacementConfig"
 const ENoExecEventKind = "ENoExecEvent"
 const ENoExecEventResource = "enoexecevents"
+const ArchitectureMismatchReportKind = "ArchitectureMismatchReport"
+const ArchitectureMismatchReportResource = "architecturemismatchreports"
+const ExecFormatErrorReportKind = "ExecFormatErrorReport"
+const ExecFormatErrorReportResource = "execformaterrorreports"
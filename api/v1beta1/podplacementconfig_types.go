@@ -19,9 +19,11 @@ package v1beta1
 import (
 	"fmt"
 
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
 	"github.com/openshift/multiarch-tuning-operator/api/common"
 	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -44,6 +46,20 @@ type PodPlacementConfigSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=255
 	Priority uint8 `json:"priority"`
+
+	// MergeStrategy defines how the preferred node affinity terms of the PodPlacementConfigs and of the
+	// ClusterPodPlacementConfig matching a pod are merged.
+	// Valid values are: "FirstMatchWins", "MergeByMaxWeight", "Sum", "Override".
+	// With FirstMatchWins, each architecture gets the weight of the highest priority configuration providing it.
+	// With MergeByMaxWeight, each architecture gets the maximum weight among the configurations providing it.
+	// With Sum, each architecture gets the sum of the weights of the configurations providing it, capped at 100.
+	// With Override, only this PodPlacementConfig is applied and the other configurations are ignored.
+	// Only the merge strategy of the highest priority PodPlacementConfig matching a pod, with the NodeAffinityScoring
+	// plugin enabled, is considered.
+	// Defaults to "FirstMatchWins".
+	// +optional
+	// +kubebuilder:default=FirstMatchWins
+	MergeStrategy common.MergeStrategy `json:"mergeStrategy,omitempty"`
 }
 
 // PodPlacementConfig defines the configuration for the architecture aware pod placement operand in a given namespace for a subset of its pods based on the provided labelSelector.
@@ -51,6 +67,11 @@ type PodPlacementConfigSpec struct {
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=podplacementconfigs,scope=Namespaced
+// +kubebuilder:printcolumn:name=Priority,JSONPath=.spec.priority,type=integer
+// +kubebuilder:printcolumn:name=Valid,JSONPath=.status.conditions[?(@.type=="Valid")].status,type=string
+// +kubebuilder:printcolumn:name=Applied,JSONPath=.status.conditions[?(@.type=="Applied")].status,type=string
+// +kubebuilder:printcolumn:name=Matched,JSONPath=.status.matchedPods,type=integer
+// +kubebuilder:printcolumn:name=LastApplied,JSONPath=.status.lastAppliedTime,type=date
 type PodPlacementConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -59,6 +80,23 @@ type PodPlacementConfig struct {
 	Status PodPlacementConfigStatus `json:"status,omitempty"`
 }
 
+// MatchesPodLabels returns true if the labelSelector selects a pod with the given labels.
+// A nil labelSelector selects all the pods. An invalid labelSelector selects no pods.
+func (p *PodPlacementConfig) MatchesPodLabels(podLabels map[string]string) bool {
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.LabelSelector)
+	if err != nil {
+		return false
+	}
+	// LabelSelectorAsSelector returns labels.Nothing() for a nil labelSelector
+	return selector == labels.Nothing() || selector.Matches(labels.Set(podLabels))
+}
+
+// AffinitySource returns the identifier of the PodPlacementConfig used as source in the
+// preferred node affinity sources annotation of the pods.
+func (p *PodPlacementConfig) AffinitySource() string {
+	return fmt.Sprintf("%s-%s", PodPlacementConfigKind, p.Name)
+}
+
 // PluginsEnabled checks if a specific plugin is enabled.
 func (p *PodPlacementConfig) PluginsEnabled(plugin common.Plugin) bool {
 	if p.Spec.Plugins != nil {
@@ -119,5 +157,64 @@ type PodPlacementConfigList struct {
 
 // PodPlacementConfigStatus defines the observed state of PodPlacementConfig
 type PodPlacementConfigStatus struct {
-	//TODO: Define the status fields for PodPlacementConfig
+	// Conditions represents the latest available observations of a PodPlacementConfig's current state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// MatchedPods is the number of non-terminated pods in the namespace selected by the labelSelector.
+	// +optional
+	MatchedPods int32 `json:"matchedPods,omitempty"`
+
+	// AppliedPods is the number of non-terminated pods in the namespace that got at least one of the
+	// preferred node affinity terms of this PodPlacementConfig.
+	// +optional
+	AppliedPods int32 `json:"appliedPods,omitempty"`
+
+	// LastAppliedTime is the creation time of the most recent pod that got at least one of the
+	// preferred node affinity terms of this PodPlacementConfig.
+	// +optional
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+
+	// Warnings lists the potential issues of the configuration, like label selectors overlapping with the ones
+	// of other PodPlacementConfigs in the same namespace, or preferences shadowed by the ones of higher priority
+	// PodPlacementConfigs.
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Build sets the conditions in the PodPlacementConfig status.
+// The build Conditions are:
+//   - Valid: if the labelSelector can be parsed and the NodeAffinityScoring plugin is enabled
+//   - Applied: if the preferences have been applied to at least one of the non-terminated pods it selects
+func (s *PodPlacementConfigStatus) Build(generation int64, validationReason, validationMessage string) {
+	if s.Conditions == nil {
+		s.Conditions = []metav1.Condition{}
+	}
+	valid := validationReason == ""
+	if valid {
+		validationReason = ValidConfigurationReason
+		validationMessage = ValidConfigurationMsg
+	}
+	v1helpers.SetCondition(&s.Conditions, metav1.Condition{
+		Type:               ValidType,
+		Status:             conditionFromBool(valid),
+		ObservedGeneration: generation,
+		Reason:             validationReason,
+		Message:            validationMessage,
+	})
+	appliedReason := PreferencesAppliedReason
+	switch {
+	case s.AppliedPods > 0:
+	case s.MatchedPods == 0:
+		appliedReason = NoMatchingPodsReason
+	default:
+		appliedReason = PreferencesNotAppliedReason
+	}
+	v1helpers.SetCondition(&s.Conditions, metav1.Condition{
+		Type:               AppliedType,
+		Status:             conditionFromBool(s.AppliedPods > 0),
+		ObservedGeneration: generation,
+		Reason:             appliedReason,
+		Message:            fmt.Sprintf(AppliedMsg, s.AppliedPods, s.MatchedPods),
+	})
 }
@@ -0,0 +1,129 @@
+package v1beta1
+
+import (
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodPlacementConfig_MatchesPodLabels(t *testing.T) {
+	tests := []struct {
+		name          string
+		labelSelector *v1.LabelSelector
+		podLabels     map[string]string
+		want          bool
+	}{
+		{
+			name:          "nil label selector matches all the pods",
+			labelSelector: nil,
+			podLabels:     map[string]string{"app": "test"},
+			want:          true,
+		},
+		{
+			name:          "empty label selector matches all the pods",
+			labelSelector: &v1.LabelSelector{},
+			podLabels:     nil,
+			want:          true,
+		},
+		{
+			name:          "matching label selector",
+			labelSelector: &v1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			podLabels:     map[string]string{"app": "test", "tier": "backend"},
+			want:          true,
+		},
+		{
+			name:          "non-matching label selector",
+			labelSelector: &v1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			podLabels:     map[string]string{"app": "other"},
+			want:          false,
+		},
+		{
+			name: "invalid label selector matches no pods",
+			labelSelector: &v1.LabelSelector{MatchExpressions: []v1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Invalid"},
+			}},
+			podLabels: map[string]string{"app": "test"},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ppc := &PodPlacementConfig{Spec: PodPlacementConfigSpec{LabelSelector: tt.labelSelector}}
+			if got := ppc.MatchesPodLabels(tt.podLabels); got != tt.want {
+				t.Errorf("MatchesPodLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodPlacementConfig_AffinitySource(t *testing.T) {
+	ppc := &PodPlacementConfig{ObjectMeta: v1.ObjectMeta{Name: "test-ppc"}}
+	if got := ppc.AffinitySource(); got != "PodPlacementConfig-test-ppc" {
+		t.Errorf("AffinitySource() = %v, want %v", got, "PodPlacementConfig-test-ppc")
+	}
+}
+
+func TestPodPlacementConfigStatus_Build(t *testing.T) {
+	tests := []struct {
+		name              string
+		status            PodPlacementConfigStatus
+		validationReason  string
+		validationMessage string
+		wantValid         v1.ConditionStatus
+		wantValidReason   string
+		wantApplied       v1.ConditionStatus
+		wantAppliedReason string
+	}{
+		{
+			name:              "valid and applied",
+			status:            PodPlacementConfigStatus{MatchedPods: 3, AppliedPods: 2},
+			wantValid:         v1.ConditionTrue,
+			wantValidReason:   ValidConfigurationReason,
+			wantApplied:       v1.ConditionTrue,
+			wantAppliedReason: PreferencesAppliedReason,
+		},
+		{
+			name:              "valid with no matching pods",
+			status:            PodPlacementConfigStatus{},
+			wantValid:         v1.ConditionTrue,
+			wantValidReason:   ValidConfigurationReason,
+			wantApplied:       v1.ConditionFalse,
+			wantAppliedReason: NoMatchingPodsReason,
+		},
+		{
+			name:              "invalid and not applied to the matching pods",
+			status:            PodPlacementConfigStatus{MatchedPods: 3},
+			validationReason:  NoPluginEnabledReason,
+			validationMessage: NoPluginEnabledMsg,
+			wantValid:         v1.ConditionFalse,
+			wantValidReason:   NoPluginEnabledReason,
+			wantApplied:       v1.ConditionFalse,
+			wantAppliedReason: PreferencesNotAppliedReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.status.Build(2, tt.validationReason, tt.validationMessage)
+			if len(tt.status.Conditions) != 2 {
+				t.Fatalf("Build() set %d conditions, want 2", len(tt.status.Conditions))
+			}
+			for _, c := range tt.status.Conditions {
+				if c.ObservedGeneration != 2 {
+					t.Errorf("condition %s has observedGeneration %d, want 2", c.Type, c.ObservedGeneration)
+				}
+				switch c.Type {
+				case ValidType:
+					if c.Status != tt.wantValid || c.Reason != tt.wantValidReason {
+						t.Errorf("Valid condition = %s/%s, want %s/%s", c.Status, c.Reason, tt.wantValid, tt.wantValidReason)
+					}
+				case AppliedType:
+					if c.Status != tt.wantApplied || c.Reason != tt.wantAppliedReason {
+						t.Errorf("Applied condition = %s/%s, want %s/%s", c.Status, c.Reason, tt.wantApplied, tt.wantAppliedReason)
+					}
+				default:
+					t.Errorf("unexpected condition type %s", c.Type)
+				}
+			}
+		})
+	}
+}
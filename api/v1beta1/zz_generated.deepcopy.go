@@ -26,6 +26,146 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureLabels) DeepCopyInto(out *ArchitectureLabels) {
+	*out = *in
+	if in.EquivalentKeys != nil {
+		in, out := &in.EquivalentKeys, &out.EquivalentKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureLabels.
+func (in *ArchitectureLabels) DeepCopy() *ArchitectureLabels {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureLabels)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureMismatch) DeepCopyInto(out *ArchitectureMismatch) {
+	*out = *in
+	if in.SupportedArchitectures != nil {
+		in, out := &in.SupportedArchitectures, &out.SupportedArchitectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureMismatch.
+func (in *ArchitectureMismatch) DeepCopy() *ArchitectureMismatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureMismatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureMismatchReport) DeepCopyInto(out *ArchitectureMismatchReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureMismatchReport.
+func (in *ArchitectureMismatchReport) DeepCopy() *ArchitectureMismatchReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureMismatchReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArchitectureMismatchReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureMismatchReportList) DeepCopyInto(out *ArchitectureMismatchReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArchitectureMismatchReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureMismatchReportList.
+func (in *ArchitectureMismatchReportList) DeepCopy() *ArchitectureMismatchReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureMismatchReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArchitectureMismatchReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureMismatchReportSpec) DeepCopyInto(out *ArchitectureMismatchReportSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureMismatchReportSpec.
+func (in *ArchitectureMismatchReportSpec) DeepCopy() *ArchitectureMismatchReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureMismatchReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureMismatchReportStatus) DeepCopyInto(out *ArchitectureMismatchReportStatus) {
+	*out = *in
+	if in.LastScanTime != nil {
+		in, out := &in.LastScanTime, &out.LastScanTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Mismatches != nil {
+		in, out := &in.Mismatches, &out.Mismatches
+		*out = make([]ArchitectureMismatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureMismatchReportStatus.
+func (in *ArchitectureMismatchReportStatus) DeepCopy() *ArchitectureMismatchReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureMismatchReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterPodPlacementConfig) DeepCopyInto(out *ClusterPodPlacementConfig) {
 	*out = *in
@@ -93,11 +233,21 @@ func (in *ClusterPodPlacementConfigSpec) DeepCopyInto(out *ClusterPodPlacementCo
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IgnoredNamespaces != nil {
+		in, out := &in.IgnoredNamespaces, &out.IgnoredNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Plugins != nil {
 		in, out := &in.Plugins, &out.Plugins
 		*out = new(plugins.Plugins)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArchitectureLabels != nil {
+		in, out := &in.ArchitectureLabels, &out.ArchitectureLabels
+		*out = new(ArchitectureLabels)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodPlacementConfigSpec.
@@ -138,7 +288,7 @@ func (in *ENoExecEvent) DeepCopyInto(out *ENoExecEvent) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENoExecEvent.
@@ -209,6 +359,18 @@ func (in *ENoExecEventSpec) DeepCopy() *ENoExecEventSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ENoExecEventStatus) DeepCopyInto(out *ENoExecEventStatus) {
 	*out = *in
+	if in.FirstSeen != nil {
+		in, out := &in.FirstSeen, &out.FirstSeen
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSeen != nil {
+		in, out := &in.LastSeen, &out.LastSeen
+		*out = (*in).DeepCopy()
+	}
+	if in.HandledAt != nil {
+		in, out := &in.HandledAt, &out.HandledAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENoExecEventStatus.
@@ -221,13 +383,167 @@ func (in *ENoExecEventStatus) DeepCopy() *ENoExecEventStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecFormatErrorContainer) DeepCopyInto(out *ExecFormatErrorContainer) {
+	*out = *in
+	if in.FirstSeen != nil {
+		in, out := &in.FirstSeen, &out.FirstSeen
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSeen != nil {
+		in, out := &in.LastSeen, &out.LastSeen
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecFormatErrorContainer.
+func (in *ExecFormatErrorContainer) DeepCopy() *ExecFormatErrorContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecFormatErrorContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecFormatErrorReport) DeepCopyInto(out *ExecFormatErrorReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecFormatErrorReport.
+func (in *ExecFormatErrorReport) DeepCopy() *ExecFormatErrorReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecFormatErrorReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExecFormatErrorReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecFormatErrorReportList) DeepCopyInto(out *ExecFormatErrorReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExecFormatErrorReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecFormatErrorReportList.
+func (in *ExecFormatErrorReportList) DeepCopy() *ExecFormatErrorReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecFormatErrorReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExecFormatErrorReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecFormatErrorReportSpec) DeepCopyInto(out *ExecFormatErrorReportSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecFormatErrorReportSpec.
+func (in *ExecFormatErrorReportSpec) DeepCopy() *ExecFormatErrorReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecFormatErrorReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecFormatErrorReportStatus) DeepCopyInto(out *ExecFormatErrorReportStatus) {
+	*out = *in
+	if in.LastSeen != nil {
+		in, out := &in.LastSeen, &out.LastSeen
+		*out = (*in).DeepCopy()
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]ExecFormatErrorWorkload, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecFormatErrorReportStatus.
+func (in *ExecFormatErrorReportStatus) DeepCopy() *ExecFormatErrorReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecFormatErrorReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecFormatErrorWorkload) DeepCopyInto(out *ExecFormatErrorWorkload) {
+	*out = *in
+	if in.NodeArchitectures != nil {
+		in, out := &in.NodeArchitectures, &out.NodeArchitectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ExecFormatErrorContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSeen != nil {
+		in, out := &in.LastSeen, &out.LastSeen
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecFormatErrorWorkload.
+func (in *ExecFormatErrorWorkload) DeepCopy() *ExecFormatErrorWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecFormatErrorWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodPlacementConfig) DeepCopyInto(out *PodPlacementConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacementConfig.
@@ -308,6 +624,22 @@ func (in *PodPlacementConfigSpec) DeepCopy() *PodPlacementConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodPlacementConfigStatus) DeepCopyInto(out *PodPlacementConfigStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacementConfigStatus.
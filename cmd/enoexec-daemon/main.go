@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/go-logr/logr"
@@ -14,24 +15,57 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
 	enoexeceventdaemon "github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon"
 )
 
 var (
 	initialLogLevel int
 	logDevMode      bool
+	opts            = enoexeceventdaemon.DefaultOptions()
 )
 
 func main() {
 	bindFlags()
 	ctx, cancel := initContext()
-	err := enoexeceventdaemon.RunDaemon(ctx, cancel)
+	err := enoexeceventdaemon.RunDaemon(ctx, cancel, opts)
 	must(err, "failed to run enoexec daemon")
 }
 
 func bindFlags() {
 	flag.IntVar(&initialLogLevel, "initial-log-level", 0, "Initial log level. From 0 (Normal) to 5 (TraceAll)")
 	flag.BoolVar(&logDevMode, "log-dev-mode", false, "Enable development mode for zap logger")
+	flag.StringVar(&opts.CRISocket, "cri-socket", "", "Path of the unix socket of the CRI runtime. "+
+		"If empty, the CRI-O, containerd and k3s sockets are looked up in their directories of the host /run directory, "+
+		"mounted under /host/run. The cri-dockerd socket must be set")
+	flag.BoolVar(&opts.ReportHostProcesses, "report-host-processes", opts.ReportHostProcesses,
+		"Report the exec format errors of the processes that do not run in a pod as node-scoped ENoExecEvents")
+	flag.Func("max-events", fmt.Sprintf("Number of events the ring buffer and the queue of the events to store can hold (default %d)",
+		opts.MaxEvents), func(value string) error {
+		maxEvents, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || maxEvents == 0 {
+			return fmt.Errorf("invalid number of events %q", value)
+		}
+		opts.MaxEvents = uint32(maxEvents)
+		return nil
+	})
+	flag.Float64Var(&opts.RateLimit, "rate-limit", opts.RateLimit, "Maximum number of writes per second of the ENoExecEvent objects")
+	flag.IntVar(&opts.Burst, "burst", opts.Burst, "Maximum number of writes of the ENoExecEvent objects at once")
+	flag.DurationVar(&opts.Timeout, "timeout", opts.Timeout, "Time an event waits for the rate limiter before it is dropped")
+	flag.Func("storage-backend", fmt.Sprintf("The backend the events are stored in: %s, %s or %s (default %s)",
+		plugins.StorageBackendENoExecEvent, plugins.StorageBackendEvent, plugins.StorageBackendLog, opts.StorageBackend),
+		func(value string) error {
+			switch backend := plugins.ExecFormatErrorStorageBackend(value); backend {
+			case plugins.StorageBackendENoExecEvent, plugins.StorageBackendEvent, plugins.StorageBackendLog:
+				opts.StorageBackend = backend
+				return nil
+			default:
+				return fmt.Errorf("invalid storage backend %q", value)
+			}
+		})
+	flag.StringVar(&opts.MetricsBindAddress, "metrics-bind-address", opts.MetricsBindAddress,
+		"The address the metrics endpoint binds to. Set to 0 to disable the metrics endpoint")
+	flag.StringVar(&opts.CertDir, "cert-dir", opts.CertDir, "The directory where the TLS certs of the metrics endpoint are stored")
 	flag.Parse()
 }
 
@@ -67,6 +67,7 @@ import (
 	enoexeceventhandler "github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/handler"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/operator"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacement"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacement/metrics"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacementconfig"
 	"github.com/openshift/multiarch-tuning-operator/pkg/informers/clusterpodplacementconfig"
 	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
@@ -91,7 +92,8 @@ var (
 	enableLeaderElection,
 	enableClusterPodPlacementConfigOperandWebHook,
 	enableClusterPodPlacementConfigOperandControllers,
-	enableENoExecEventControllers bool
+	enableENoExecEventControllers,
+	retainENoExecEvents bool
 	enableCPPCInformer bool
 	enableOperator     bool
 	initialLogLevel    int
@@ -237,6 +239,13 @@ func RunOperator(mgr ctrl.Manager) {
 	}).SetupWithManager(mgr), unableToCreateController, controllerKey, "ClusterPodPlacementConfig")
 	must((&multiarchv1beta1.ClusterPodPlacementConfig{}).SetupWebhookWithManager(mgr), unableToCreateController,
 		controllerKey, "ClusterPodPlacementConfigConversionWebhook")
+	// The scheduling gate watchdog runs in the operator so that the pods are ungated when the pod placement
+	// controller is not available.
+	must(mgr.Add(podplacement.NewSchedulingGateWatchdog(mgr.GetClient(), clientset,
+		mgr.GetEventRecorderFor(utils.OperatorName))), //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
+		unableToAddRunnable, runnableKey, "SchedulingGateWatchdog")
+	must(mgr.Add(operator.NewENoExecEventGarbageCollector(mgr.GetClient())),
+		unableToAddRunnable, runnableKey, "ENoExecEventGarbageCollector")
 }
 
 func RunClusterPodPlacementConfigOperandControllers(mgr ctrl.Manager) {
@@ -252,8 +261,36 @@ func RunClusterPodPlacementConfigOperandControllers(mgr ctrl.Manager) {
 	}).SetupWithManager(mgr),
 		unableToCreateController, controllerKey, "PodReconciler")
 
+	must((&podplacement.PodAuditReconciler{
+		PodReconciler: podplacement.PodReconciler{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+			Scheme:    mgr.GetScheme(),
+			ClientSet: clientset,
+			Recorder:  mgr.GetEventRecorderFor(utils.OperatorName), //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
+		},
+	}).SetupWithManager(mgr),
+		unableToCreateController, controllerKey, "PodAuditReconciler")
+
+	must((&podplacementconfig.PodPlacementConfigReconciler{
+		Client:    mgr.GetClient(),
+		APIReader: mgr.GetAPIReader(),
+		Scheme:    mgr.GetScheme(),
+	}).SetupWithManager(mgr),
+		unableToCreateController, controllerKey, "PodPlacementConfigReconciler")
+
 	must(mgr.Add(podplacement.NewGlobalPullSecretSyncer(clientset, globalPullSecretNamespace, globalPullSecretName)),
 		unableToAddRunnable, runnableKey, "GlobalPullSecretSyncer")
+
+	must(mgr.Add(podplacement.NewRunningPodsScanner(mgr.GetClient(), mgr.GetAPIReader(), clientset, mgr.GetScheme(),
+		mgr.GetEventRecorderFor(utils.OperatorName))), //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
+		unableToAddRunnable, runnableKey, "RunningPodsScanner")
+
+	must(mgr.Add(podplacement.NewNodeCapacitySyncer(mgr.GetClient(), mgr.GetAPIReader(), clientset)),
+		unableToAddRunnable, runnableKey, "NodeCapacitySyncer")
+
+	// The gated pods are counted from the cache of the manager, that holds the pending pods, by the leader only.
+	metrics.RegisterGatedPodsCollector(mgr.GetClient(), mgr.Elected())
 }
 
 func RunClusterPodPlacementConfigOperandWebHook(mgr ctrl.Manager) {
@@ -284,9 +321,11 @@ func RunENoExecEventControllers(mgr ctrl.Manager) {
 	clientset := kubernetes.NewForConfigOrDie(config)
 	must(enoexeceventhandler.NewReconciler(
 		mgr.GetClient(),
+		mgr.GetAPIReader(),
 		clientset,
 		mgr.GetScheme(),
 		mgr.GetEventRecorderFor(utils.EnoexecControllerName), //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
+		retainENoExecEvents,
 	).SetupWithManager(mgr), unableToCreateController, controllerKey, "ENoExecEventController")
 }
 
@@ -317,6 +356,7 @@ func bindFlags() {
 	flag.BoolVar(&enableOperator, "enable-operator", false, "Enable the operator")
 	flag.BoolVar(&enableCPPCInformer, "enable-cppc-informer", false, "Enable informer for ClusterPodPlacementConfig")
 	flag.BoolVar(&enableENoExecEventControllers, "enable-enoexec-event-controllers", false, "Enable the ENoExecEvent controllers")
+	flag.BoolVar(&retainENoExecEvents, "retain-enoexec-events", false, "Keep the reconciled ENoExecEvents with the outcome of the reconciliation in their status, instead of deleting them")
 	// This may be deprecated in the future. It is used to support the current way of setting the log level for operands
 	// If operands will start to support a controller that watches the ClusterPodPlacementConfig, this flag may be removed
 	// and the log level will be set in the ClusterPodPlacementConfig at runtime (with no need for reconciliation)
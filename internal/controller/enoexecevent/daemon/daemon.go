@@ -2,6 +2,8 @@ package daemon
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
@@ -10,21 +12,76 @@ import (
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
 
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/storage"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/tracepoint"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
 )
 
-func RunDaemon(ctx context.Context, cancel context.CancelFunc) error {
+// Options are the options of the ENoExecEvent daemon.
+type Options struct {
+	// CRISocket is the path of the unix socket of the CRI runtime; it is autodetected if empty.
+	CRISocket string
+	// ReportHostProcesses enables the node-scoped ENoExecEvents of the processes that do not run in a pod.
+	ReportHostProcesses bool
+	// MaxEvents is the number of events the ring buffer and the queue of the events to store can hold.
+	MaxEvents uint32
+	// RateLimit and Burst configure the rate limiter of the writes of the ENoExecEvent objects.
+	RateLimit float64
+	Burst     int
+	// Timeout is the time an event waits for the rate limiter before it is dropped.
+	Timeout time.Duration
+	// StorageBackend is the backend the events are stored in.
+	StorageBackend plugins.ExecFormatErrorStorageBackend
+	// MetricsBindAddress is the address the metrics endpoint binds to; "0" disables it.
+	MetricsBindAddress string
+	// CertDir is the directory of the TLS certificate and key of the metrics endpoint.
+	CertDir string
+}
+
+// DefaultOptions returns the default options of the ENoExecEvent daemon.
+func DefaultOptions() Options {
+	return Options{
+		MaxEvents: 256,
+		RateLimit: 5,
+		Burst:     10,
+		Timeout:   time.Minute,
+
+		StorageBackend: plugins.StorageBackendENoExecEvent,
+
+		MetricsBindAddress: "0",
+		CertDir:            "/var/run/manager/tls",
+	}
+}
+
+// RunDaemon runs the ENoExecEvent daemon until the context is done.
+func RunDaemon(ctx context.Context, cancel context.CancelFunc, opts Options) error {
 	log, err := logr.FromContext(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get logger from context: %w", err)
 	}
+	metrics.InitMetrics()
 	var (
-		maxEvents uint32     = 256
-		rateLimit rate.Limit = 5
-		burst                = 10
-		timeout              = time.Minute
+		maxEvents = opts.MaxEvents
+		rateLimit = rate.Limit(opts.RateLimit)
+		burst     = opts.Burst
+		timeout   = opts.Timeout
+		// aggregationWindow is the time without errors after which the repeated errors of a pod container are no
+		// longer aggregated in the same ENoExecEvent. The aggregated errors are written every flushInterval.
+		aggregationWindow = 5 * time.Minute
+		flushInterval     = 30 * time.Second
+		// podCacheSyncTimeout is the time the daemon waits for the cache of the pods of the node to sync before
+		// starting the tracepoint. The pods that are not cached yet are resolved through the CRI runtime.
+		podCacheSyncTimeout = 30 * time.Second
 	)
 
 	log.Info("Initializing channel")
@@ -33,16 +90,52 @@ func RunDaemon(ctx context.Context, cancel context.CancelFunc) error {
 	nodeName := os.Getenv("NODE_NAME")
 	namespace := os.Getenv("NAMESPACE")
 
-	log.Info("Initializing storage", "node_name", nodeName, "namespace", namespace,
-		"rate limit", rateLimit, "burst", burst, "timeout", timeout)
-	storageImpl, err := storage.NewK8sENOExecEventStorage(
-		ctx, rate.NewLimiter(rateLimit, burst), ch, nodeName, namespace, timeout)
+	log.Info("Initializing storage", "storage_backend", opts.StorageBackend, "node_name", nodeName,
+		"namespace", namespace, "rate limit", rateLimit, "burst", burst, "timeout", timeout,
+		"aggregation_window", aggregationWindow, "flush_interval", flushInterval)
+	var storageImpl storage.IStorage
+	switch opts.StorageBackend {
+	case plugins.StorageBackendENoExecEvent:
+		storageImpl, err = storage.NewK8sENOExecEventStorage(
+			ctx, rate.NewLimiter(rateLimit, burst), ch, nodeName, namespace, timeout, aggregationWindow, flushInterval)
+	case plugins.StorageBackendEvent:
+		storageImpl, err = storage.NewK8sEventStorage(ctx, rate.NewLimiter(rateLimit, burst), ch, nodeName, timeout)
+	case plugins.StorageBackendLog:
+		storageImpl, err = storage.NewLogStorage(ctx, ch, nodeName, os.Stdout)
+	default:
+		err = fmt.Errorf("unknown storage backend %q", opts.StorageBackend)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create storage: %w", err)
 	}
 
-	log.Info("Initializing tracepoint")
-	tp, err := tracepoint.NewTracepoint(ctx, ch, maxEvents)
+	config, err := controllerruntime.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get the Kubernetes client config: %w", err)
+	}
+
+	log.Info("Initializing the metrics server", "bind_address", opts.MetricsBindAddress, "cert_dir", opts.CertDir)
+	metricsServer, err := newMetricsServer(config, opts)
+	if err != nil {
+		return fmt.Errorf("failed to create the metrics server: %w", err)
+	}
+
+	log.Info("Initializing the pods cache", "node_name", nodeName)
+	podInformer, err := newNodePodInformer(config, nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to create the pods informer: %w", err)
+	}
+	go podInformer.Run(ctx.Done())
+	syncCtx, syncCancel := context.WithTimeout(ctx, podCacheSyncTimeout)
+	if !cache.WaitForCacheSync(syncCtx.Done(), podInformer.HasSynced) {
+		log.Info("The pods cache did not sync in time, falling back to the CRI runtime until it does",
+			"timeout", podCacheSyncTimeout)
+	}
+	syncCancel()
+
+	log.Info("Initializing tracepoint", "cri_socket", opts.CRISocket, "report_host_processes", opts.ReportHostProcesses,
+		"max_events", maxEvents)
+	tp, err := tracepoint.NewTracepoint(ctx, ch, maxEvents, opts.CRISocket, podInformer.GetIndexer(), opts.ReportHostProcesses)
 	if err != nil {
 		return fmt.Errorf("failed to create tracepoint: %w", err)
 	}
@@ -53,6 +146,8 @@ func RunDaemon(ctx context.Context, cancel context.CancelFunc) error {
 	go runWorker("Kubernetes storage writer", &wg, ctx, cancel, storageImpl.Run)
 	wg.Add(1)
 	go runWorker("ENOEXEC eBPF tracepoint", &wg, ctx, cancel, tp.Run)
+	wg.Add(1)
+	go runWorker("metrics server", &wg, ctx, cancel, func() error { return metricsServer.Start(ctx) })
 	log.Info("Controller started, waiting for events")
 
 	<-ctx.Done()
@@ -65,6 +160,39 @@ func RunDaemon(ctx context.Context, cancel context.CancelFunc) error {
 	return nil
 }
 
+// newMetricsServer returns the server of the metrics of the daemon. As for the operands, the metrics are served over
+// https and the scrapes are authenticated and authorized through the Kubernetes API server. HTTP/2 is disabled to
+// mitigate the Rapid Reset CVEs.
+func newMetricsServer(config *rest.Config, opts Options) (metricsserver.Server, error) {
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the HTTP client: %w", err)
+	}
+	return metricsserver.NewServer(metricsserver.Options{
+		BindAddress:    opts.MetricsBindAddress,
+		CertDir:        opts.CertDir,
+		FilterProvider: filters.WithAuthenticationAndAuthorization,
+		SecureServing:  true,
+		TLSOpts: []func(*tls.Config){
+			func(c *tls.Config) {
+				c.NextProtos = []string{"http/1.1"}
+			},
+		},
+	}, config, httpClient)
+}
+
+// newNodePodInformer returns the informer of the pods scheduled to the node the daemon runs on.
+func newNodePodInformer(config *rest.Config, nodeName string) (cache.SharedIndexInformer, error) {
+	if nodeName == "" {
+		return nil, errors.New("the NODE_NAME environment variable is not set")
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Kubernetes clientset: %w", err)
+	}
+	return tracepoint.NewNodePodInformer(clientset, nodeName, 0)
+}
+
 func runWorker(name string, wg *sync.WaitGroup,
 	ctx context.Context, cancelFn func(), runFn func() error) {
 
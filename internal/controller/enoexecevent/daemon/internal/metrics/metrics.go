@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"sync"
+
+	metrics2 "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+const (
+	// DroppedRingBufferFull is the reason of the events dropped by the eBPF program because the ring buffer is full.
+	DroppedRingBufferFull = "ring_buffer_full"
+	// DroppedChannelFull is the reason of the events dropped because the queue of the events to store is full.
+	DroppedChannelFull = "channel_full"
+	// DroppedRateLimited is the reason of the events dropped because they waited for the rate limiter for too long.
+	DroppedRateLimited = "rate_limited"
+)
+
+const (
+	// ScopePod is the scope of the events resolved to a pod container.
+	ScopePod = "pod"
+	// ScopeNode is the scope of the events resolved to a process not running in a pod.
+	ScopeNode = "node"
+)
+
+const (
+	// ResultSuccess is the result of the ring buffer reads returning a record.
+	ResultSuccess = "success"
+	// ResultError is the result of the ring buffer reads failing.
+	ResultError = "error"
+)
+
+const (
+	// OperationCreate is the operation of the storage failures creating an ENoExecEvent object.
+	OperationCreate = "create"
+	// OperationUpdate is the operation of the storage failures updating the count of an ENoExecEvent object.
+	OperationUpdate = "update"
+)
+
+var (
+	DroppedEvents    *prometheus.CounterVec
+	ObservedEvents   prometheus.Counter
+	ResolvedEvents   *prometheus.CounterVec
+	UnresolvedEvents prometheus.Counter
+	TimeToQueryCRI   prometheus.Histogram
+	RingBufferReads  *prometheus.CounterVec
+	StorageFailures  *prometheus.CounterVec
+)
+
+var onceCommon sync.Once
+
+func initMetrics() {
+	onceCommon.Do(func() {
+		DroppedEvents = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mto_enoexec_daemon_dropped_events_total",
+				Help: "The counter for ENOEXEC events dropped by the ENoExecEvent daemon, by reason",
+			},
+			[]string{"reason"},
+		)
+		ObservedEvents = prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "mto_enoexec_daemon_observed_events_total",
+				Help: "The counter for ENOEXEC events read from the ring buffer by the ENoExecEvent daemon",
+			},
+		)
+		ResolvedEvents = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mto_enoexec_daemon_resolved_events_total",
+				Help: "The counter for ENOEXEC events resolved to a pod container or a host process, by scope",
+			},
+			[]string{"scope"},
+		)
+		UnresolvedEvents = prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "mto_enoexec_daemon_unresolved_events_total",
+				Help: "The counter for ENOEXEC events that could not be resolved to a pod container or a host process",
+			},
+		)
+		TimeToQueryCRI = prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "mto_enoexec_daemon_time_to_query_cri_seconds",
+				Help:    "Time taken by the CRI runtime to answer the lookups of the pods and of the runtime name",
+				Buckets: utils.Buckets(),
+			},
+		)
+		RingBufferReads = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mto_enoexec_daemon_ring_buffer_reads_total",
+				Help: "The counter for reads of the ring buffer of the eBPF program, by result",
+			},
+			[]string{"result"},
+		)
+		StorageFailures = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mto_enoexec_daemon_storage_failures_total",
+				Help: "The counter for failures to write the ENoExecEvent objects, by operation",
+			},
+			[]string{"operation"},
+		)
+		metrics2.Registry.MustRegister(DroppedEvents, ObservedEvents, ResolvedEvents, UnresolvedEvents, TimeToQueryCRI,
+			RingBufferReads, StorageFailures)
+		// Initialize the counters of all the labels so that they are reported before the first increment
+		for _, reason := range []string{DroppedRingBufferFull, DroppedChannelFull, DroppedRateLimited} {
+			DroppedEvents.WithLabelValues(reason)
+		}
+		for _, scope := range []string{ScopePod, ScopeNode} {
+			ResolvedEvents.WithLabelValues(scope)
+		}
+		for _, result := range []string{ResultSuccess, ResultError} {
+			RingBufferReads.WithLabelValues(result)
+		}
+		for _, operation := range []string{OperationCreate, OperationUpdate} {
+			StorageFailures.WithLabelValues(operation)
+		}
+	})
+}
+
+func InitMetrics() {
+	initMetrics()
+}
@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+)
+
+// aggregatedEvent is the state of the ENoExecEvent object aggregating the exec format errors of a pod container, or of
+// a host cgroup.
+type aggregatedEvent struct {
+	key string
+	// name is the name of the ENoExecEvent object storing the aggregated errors, empty until it is created.
+	name string
+	// status is the status of the ENoExecEvent object, with the count and the first and last seen times of the errors,
+	// and the ID of the last container of the pod raising them.
+	status multiarchv1beta1.ENoExecEventStatus
+	// pending is true when errors were aggregated after the object was last written.
+	pending bool
+	// pendingCount and pendingFirstSeen are the count and the first seen time of the errors aggregated after the
+	// object was last written. They are the only errors written to the new object replacing the one consumed by the
+	// ENoExecEvent handler, so that the errors already reported are not reported again.
+	pendingCount     int32
+	pendingFirstSeen *metav1.Time
+}
+
+// written resets the pending errors of the entry, after they are written to its ENoExecEvent object.
+func (e *aggregatedEvent) written() {
+	e.pending = false
+	e.pendingCount = 0
+	e.pendingFirstSeen = nil
+}
+
+// pendingStatus returns the status of a new ENoExecEvent object with the pending errors of the entry only.
+func (e *aggregatedEvent) pendingStatus() multiarchv1beta1.ENoExecEventStatus {
+	status := *e.status.DeepCopy()
+	status.Count = e.pendingCount
+	status.FirstSeen = e.pendingFirstSeen
+	return status
+}
+
+// aggregator deduplicates the exec format errors of the same pod container, or host cgroup, within a sliding window:
+// the first error creates an ENoExecEvent object, the repeats increase its count and last seen time and are written in
+// batches. A crash-looping container then produces one object, updated periodically, instead of one per restart.
+// The aggregator is not concurrency-safe: it is only used by the main loop of the storage.
+type aggregator struct {
+	// window is the time after the last error of a key when its entry is dropped: a later error creates a new object.
+	window time.Duration
+	// maxEntries bounds the memory used by the aggregator. When it is reached, the errors of new keys are not
+	// aggregated.
+	maxEntries int
+	entries    map[string]*aggregatedEvent
+	now        func() time.Time
+}
+
+// maxAggregatedEvents is the maximum number of keys tracked by the aggregator of the ENoExecEvent daemon.
+const maxAggregatedEvents = 1024
+
+func newAggregator(window time.Duration, maxEntries int) *aggregator {
+	return &aggregator{
+		window:     window,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*aggregatedEvent),
+		now:        time.Now,
+	}
+}
+
+// aggregationKey returns the key the errors are aggregated by: the pod and container name for the events of the pods,
+// the cgroup path for the node-scoped ones. The container ID, that changes at each restart of the container, is only
+// used when the name of the container is unknown.
+func aggregationKey(status *multiarchv1beta1.ENoExecEventStatus, containerName string) string {
+	if status.IsNodeScoped() {
+		return "host/" + status.CgroupPath
+	}
+	if containerName == "" {
+		return "pod/" + status.PodNamespace + "/" + status.PodName + "/id/" + status.ContainerID
+	}
+	return "pod/" + status.PodNamespace + "/" + status.PodName + "/name/" + containerName
+}
+
+// observe records an exec format error of the container with the given name, if known. It returns the entry of its key
+// and true if the error is the first of the key in the window, in which case the caller creates the ENoExecEvent object
+// from the entry status. Otherwise, the entry is marked as pending, to be written at the next flush.
+func (a *aggregator) observe(status *multiarchv1beta1.ENoExecEventStatus, containerName string) (*aggregatedEvent, bool) {
+	now := metav1.NewTime(a.now())
+	key := aggregationKey(status, containerName)
+	if entry, ok := a.entries[key]; ok && (entry.pending || now.Sub(entry.status.LastSeen.Time) < a.window) {
+		entry.status.Count++
+		entry.status.LastSeen = &now
+		// The container may have been restarted with a new ID.
+		entry.status.ContainerID = status.ContainerID
+		if !entry.pending {
+			entry.pendingFirstSeen = &now
+		}
+		entry.pendingCount++
+		entry.pending = true
+		return entry, false
+	}
+	entry := &aggregatedEvent{key: key, status: *status.DeepCopy()}
+	entry.status.Count = 1
+	entry.status.FirstSeen = &now
+	entry.status.LastSeen = &now
+	if len(a.entries) < a.maxEntries {
+		a.entries[key] = entry
+	}
+	return entry, true
+}
+
+// forget drops the entry, e.g., when its object could not be created.
+func (a *aggregator) forget(entry *aggregatedEvent) {
+	if a.entries[entry.key] == entry {
+		delete(a.entries, entry.key)
+	}
+}
+
+// flush returns the entries with pending errors, to be written by the caller, and drops the entries whose last error
+// is older than the window.
+func (a *aggregator) flush() []*aggregatedEvent {
+	now := a.now()
+	pending := make([]*aggregatedEvent, 0)
+	for key, entry := range a.entries {
+		if entry.pending {
+			pending = append(pending, entry)
+			continue
+		}
+		if now.Sub(entry.status.LastSeen.Time) >= a.window {
+			delete(a.entries, key)
+		}
+	}
+	return pending
+}
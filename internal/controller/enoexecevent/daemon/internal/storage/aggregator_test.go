@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestAggregator(maxEntries int) (*aggregator, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	a := newAggregator(5*time.Minute, maxEntries)
+	a.now = clock.Now
+	return a, clock
+}
+
+func podStatus(podName, containerID string) *multiarchv1beta1.ENoExecEventStatus {
+	return &multiarchv1beta1.ENoExecEventStatus{
+		NodeName:     "test-node",
+		PodName:      podName,
+		PodNamespace: "test-ns",
+		ContainerID:  containerID,
+	}
+}
+
+func TestAggregator_observe(t *testing.T) {
+	a, clock := newTestAggregator(maxAggregatedEvents)
+	first := clock.now
+
+	entry, isNew := a.observe(podStatus("test-pod", "cri-o://a"), "")
+	if !isNew || entry.status.Count != 1 || !entry.status.FirstSeen.Time.Equal(first) || !entry.status.LastSeen.Time.Equal(first) {
+		t.Fatalf("observe() = (%+v, %v), want a new entry with count 1 first and last seen at %v", entry.status, isNew, first)
+	}
+	entry.name = "test-event"
+
+	clock.now = first.Add(time.Minute)
+	repeat, isNew := a.observe(podStatus("test-pod", "cri-o://a"), "")
+	if isNew || repeat != entry {
+		t.Fatalf("observe() of a repeat = (%+v, %v), want the existing entry", repeat, isNew)
+	}
+	if entry.status.Count != 2 || !entry.status.FirstSeen.Time.Equal(first) || !entry.status.LastSeen.Time.Equal(clock.now) || !entry.pending {
+		t.Errorf("the repeated entry is %+v, pending %v, want count 2, first seen %v, last seen %v, pending",
+			entry.status, entry.pending, first, clock.now)
+	}
+
+	if _, isNew = a.observe(podStatus("test-pod", "cri-o://b"), ""); !isNew {
+		t.Errorf("observe() of another container should create a new entry")
+	}
+	if _, isNew = a.observe(podStatus("other-pod", "cri-o://a"), ""); !isNew {
+		t.Errorf("observe() of another pod should create a new entry")
+	}
+	restarted, isNew := a.observe(podStatus("test-pod", "cri-o://c"), "app")
+	if !isNew {
+		t.Fatalf("observe() of a named container should create a new entry")
+	}
+	if repeat, isNew = a.observe(podStatus("test-pod", "cri-o://d"), "app"); isNew || repeat != restarted {
+		t.Errorf("observe() of a restarted container = (%+v, %v), want the entry of the container name", repeat, isNew)
+	}
+	if restarted.status.ContainerID != "cri-o://d" {
+		t.Errorf("the container ID of the entry is %q, want the latest one", restarted.status.ContainerID)
+	}
+	host := &multiarchv1beta1.ENoExecEventStatus{NodeName: "test-node", CgroupPath: "/system.slice/my-agent.service"}
+	if _, isNew = a.observe(host, ""); !isNew {
+		t.Errorf("observe() of a host process should create a new entry")
+	}
+	if _, isNew = a.observe(host, ""); isNew {
+		t.Errorf("observe() of a repeated host process should aggregate it")
+	}
+}
+
+func TestAggregator_flush(t *testing.T) {
+	a, clock := newTestAggregator(maxAggregatedEvents)
+	repeated, _ := a.observe(podStatus("repeated-pod", "cri-o://a"), "")
+	single, _ := a.observe(podStatus("single-pod", "cri-o://a"), "")
+	a.observe(podStatus("repeated-pod", "cri-o://a"), "")
+
+	clock.now = clock.now.Add(time.Minute)
+	a.observe(podStatus("repeated-pod", "cri-o://a"), "")
+	pending := a.flush()
+	if len(pending) != 1 || pending[0] != repeated {
+		t.Fatalf("flush() = %v, want the repeated entry only", pending)
+	}
+	if status := repeated.pendingStatus(); status.Count != 2 || !status.FirstSeen.Time.Equal(repeated.status.FirstSeen.Time) {
+		t.Errorf("the pending status is %+v, want count 2 since the first seen time", status)
+	}
+	repeated.written()
+	if a.observe(podStatus("repeated-pod", "cri-o://a"), ""); repeated.status.Count != 4 {
+		t.Errorf("the count of the object is %d, want 4", repeated.status.Count)
+	}
+	if status := repeated.pendingStatus(); status.Count != 1 || !status.FirstSeen.Time.Equal(clock.now) {
+		t.Errorf("the pending status after a write is %+v, want count 1 first seen at %v", status, clock.now)
+	}
+	repeated.written()
+
+	clock.now = clock.now.Add(5 * time.Minute)
+	if pending = a.flush(); len(pending) != 0 {
+		t.Errorf("flush() = %v, want no pending entry", pending)
+	}
+	if len(a.entries) != 0 {
+		t.Errorf("the entries older than the window should be dropped, got %d entries", len(a.entries))
+	}
+	if entry, isNew := a.observe(podStatus("single-pod", "cri-o://a"), ""); !isNew || entry == single || entry.status.Count != 1 {
+		t.Errorf("observe() after the window = (%+v, %v), want a new entry with count 1", entry.status, isNew)
+	}
+}
+
+func TestAggregator_windowWithoutFlush(t *testing.T) {
+	a, clock := newTestAggregator(maxAggregatedEvents)
+	a.observe(podStatus("test-pod", "cri-o://a"), "")
+	clock.now = clock.now.Add(10 * time.Minute)
+	if entry, isNew := a.observe(podStatus("test-pod", "cri-o://a"), ""); !isNew || entry.status.Count != 1 {
+		t.Errorf("observe() after the window = (%+v, %v), want a new entry with count 1", entry.status, isNew)
+	}
+}
+
+func TestAggregator_forget(t *testing.T) {
+	a, _ := newTestAggregator(maxAggregatedEvents)
+	entry, _ := a.observe(podStatus("test-pod", "cri-o://a"), "")
+	a.forget(entry)
+	if _, isNew := a.observe(podStatus("test-pod", "cri-o://a"), ""); !isNew {
+		t.Errorf("observe() after forget should create a new entry")
+	}
+}
+
+func TestAggregator_maxEntries(t *testing.T) {
+	a, _ := newTestAggregator(1)
+	a.observe(podStatus("first-pod", "cri-o://a"), "")
+	a.observe(podStatus("second-pod", "cri-o://a"), "")
+	if _, isNew := a.observe(podStatus("second-pod", "cri-o://a"), ""); !isNew {
+		t.Errorf("the errors of the keys above the limit should not be aggregated")
+	}
+	if len(a.entries) != 1 {
+		t.Errorf("the aggregator tracks %d entries, want 1", len(a.entries))
+	}
+}
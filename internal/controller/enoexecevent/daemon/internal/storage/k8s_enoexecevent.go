@@ -10,8 +10,11 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/util/retry"
 
@@ -21,6 +24,7 @@ import (
 	"github.com/go-logr/logr"
 
 	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
 	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
 )
@@ -33,10 +37,18 @@ type K8sENOExecEventStorage struct {
 	limiter   *rate.Limiter
 	timeout   time.Duration
 	k8sClient client.Client
+
+	// aggregator deduplicates the repeated errors of the same pod container, whose objects are updated every
+	// flushInterval.
+	aggregator    *aggregator
+	flushInterval time.Duration
 }
 
 // NewK8sENOExecEventStorage creates a new K8sENOExecEventStorage instance.
-func NewK8sENOExecEventStorage(ctx context.Context, limiter *rate.Limiter, ch chan *types.ENOEXECInternalEvent, nodeName, namespace string, timeout time.Duration) (*K8sENOExecEventStorage, error) {
+// The errors of the same pod container are aggregated in a single ENoExecEvent object until no error occurs for the
+// aggregationWindow; the object is updated with the repeated errors every flushInterval.
+func NewK8sENOExecEventStorage(ctx context.Context, limiter *rate.Limiter, ch chan *types.ENOEXECInternalEvent, nodeName, namespace string,
+	timeout, aggregationWindow, flushInterval time.Duration) (*K8sENOExecEventStorage, error) {
 	log, err := logr.FromContext(ctx)
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, "Failed to get logger:", err)
@@ -69,13 +81,17 @@ func NewK8sENOExecEventStorage(ctx context.Context, limiter *rate.Limiter, ch ch
 		limiter:   limiter,
 		timeout:   timeout,
 		k8sClient: k8sClient,
+
+		aggregator:    newAggregator(aggregationWindow, maxAggregatedEvents),
+		flushInterval: flushInterval,
 	}, nil
 }
 
 // Run starts the K8sENOExecEventStorage event loop.
 //
 // It listens for ENOEXEC events on the internal channel, converts each event to a Kubernetes ENoExecEvent,
-// and creates it in the cluster.
+// and creates it in the cluster, or aggregates it in the object of the previous errors of the same pod container.
+// The aggregated errors are written every flushInterval.
 // This method is designed to run in a separate goroutine so that the responsibilities of catching ENOEXEC
 // and notifying the controller pod are handled concurrently.
 func (s *K8sENOExecEventStorage) Run() error {
@@ -86,8 +102,12 @@ func (s *K8sENOExecEventStorage) Run() error {
 	}
 	defer utils.ShouldStdErr(s.close)
 
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
 	for {
 		select {
+		case <-ticker.C:
+			s.flush()
 		case event := <-s.ch:
 			if event == nil {
 				log.Info("Received nil event, skipping")
@@ -104,6 +124,7 @@ func (s *K8sENOExecEventStorage) Run() error {
 }
 
 // processEvent processes an ENOEXECInternalEvent by converting it to an ENoExecEvent and creating it in Kubernetes.
+// The repeated errors of the same pod container are aggregated instead, and written by flush.
 // It implements throttling to avoid overwhelming the Kubernetes API server with too many requests when events
 // are generated at a high rate (e.g., when the pod is restarted frequently or when the binary that fails to execute
 // is frequently restarted within the pod).
@@ -127,6 +148,12 @@ func (s *K8sENOExecEventStorage) processEvent(event *types.ENOEXECInternalEvent)
 	if err != nil {
 		return err
 	}
+	entry, isNew := s.aggregator.observe(&enoexecEvent.Status, event.ContainerName)
+	if !isNew {
+		log.V(4).Info("Aggregated ENOExec event", "event", entry.name, "count", entry.status.Count)
+		return nil
+	}
+	enoexecEvent.Status = entry.status
 	// We implement throttling to avoid overwhelming the Kubernetes API server with too many requests when
 	// events are generate at a high rate (e.g., when the pod is restarted frequently or when the binary that
 	// fails to execute is frequently restarted within the pod).
@@ -137,13 +164,88 @@ func (s *K8sENOExecEventStorage) processEvent(event *types.ENOEXECInternalEvent)
 	ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
 	defer cancel()
 	if err = s.limiter.Wait(ctx); err != nil {
+		s.aggregator.forget(entry)
+		metrics.DroppedEvents.WithLabelValues(metrics.DroppedRateLimited).Inc()
 		return fmt.Errorf("rate limiter wait failed: %w", err)
 	}
+	if err = s.create(enoexecEvent); err != nil {
+		s.aggregator.forget(entry)
+		return err
+	}
+	entry.name = enoexecEvent.Name
+	return nil
+}
+
+// flush writes the errors aggregated since the last flush. The entries failing to be written are retried at the next
+// flush.
+func (s *K8sENOExecEventStorage) flush() {
+	log := logr.FromContextOrDiscard(s.ctx)
+	for _, entry := range s.aggregator.flush() {
+		if err := s.writeAggregatedEvent(entry); err != nil {
+			log.Error(err, "Failed to write the aggregated ENOExec events", "event", entry.name, "count", entry.status.Count)
+			continue
+		}
+		entry.written()
+	}
+}
+
+// writeAggregatedEvent updates the count, the last seen time and the container ID of the ENoExecEvent object of the
+// entry. If the object was already consumed by the handler, deleted or retained with its outcome, a new one is created
+// with the errors aggregated since the last write only, as the previous ones were already reported.
+func (s *K8sENOExecEventStorage) writeAggregatedEvent(entry *aggregatedEvent) error {
+	ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
+	defer cancel()
+	if err := s.limiter.Wait(ctx); err != nil {
+		// The pending errors are retried at the next flush, but the write is counted as dropped, as in processEvent.
+		metrics.DroppedEvents.WithLabelValues(metrics.DroppedRateLimited).Inc()
+		return fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+	// handled is true if the object was retained by the handler after its reconciliation: it is not reconciled again.
+	handled := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		enoexecEvent := &multiarchv1beta1.ENoExecEvent{}
+		if err := s.k8sClient.Get(s.ctx, client.ObjectKey{Name: entry.name, Namespace: s.namespace}, enoexecEvent); err != nil {
+			return err
+		}
+		if handled = enoexecEvent.Status.IsHandled(); handled {
+			return nil
+		}
+		enoexecEvent.Status.Count = entry.status.Count
+		enoexecEvent.Status.LastSeen = entry.status.LastSeen
+		enoexecEvent.Status.ContainerID = entry.status.ContainerID
+		return s.k8sClient.Status().Update(s.ctx, enoexecEvent)
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		metrics.StorageFailures.WithLabelValues(metrics.OperationUpdate).Inc()
+		return err
+	}
+	if err == nil && !handled {
+		return nil
+	}
+	status := entry.pendingStatus()
+	enoexecEvent := &multiarchv1beta1.ENoExecEvent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      string(uuid.NewUUID()),
+			Namespace: s.namespace,
+		},
+		Status: status,
+	}
+	if err := s.create(enoexecEvent); err != nil {
+		return err
+	}
+	entry.name = enoexecEvent.Name
+	entry.status = status
+	return nil
+}
 
+// create creates the ENoExecEvent object and sets its status. The object is deleted if its status cannot be set.
+func (s *K8sENOExecEventStorage) create(enoexecEvent *multiarchv1beta1.ENoExecEvent) error {
+	log := logr.FromContextOrDiscard(s.ctx)
 	// Save the status before Create, since Create will clear it (status is a subresource).
 	savedStatus := enoexecEvent.Status.DeepCopy()
-	err = s.k8sClient.Create(s.ctx, enoexecEvent)
+	err := s.k8sClient.Create(s.ctx, enoexecEvent)
 	if err != nil {
+		metrics.StorageFailures.WithLabelValues(metrics.OperationCreate).Inc()
 		return fmt.Errorf("failed to create ENOExecEvent in Kubernetes: %w", err)
 	}
 	// If the status update fails, delete the CR to avoid leaving an orphan that the handler
@@ -156,7 +258,7 @@ func (s *K8sENOExecEventStorage) processEvent(event *types.ENOEXECInternalEvent)
 		}
 	}
 
-	log.Info("Successfully created ENOExecEvent in Kubernetes", "event", enoexecEvent.Name, "pod_name", savedStatus.PodName, "pod_namespace", savedStatus.PodNamespace, "container_id", savedStatus.ContainerID)
+	log.Info("Successfully created ENOExecEvent in Kubernetes", "event", enoexecEvent.Name, "pod_name", savedStatus.PodName, "pod_namespace", savedStatus.PodNamespace, "container_id", savedStatus.ContainerID, "count", savedStatus.Count)
 
 	firstAttempt := true
 	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -171,6 +273,7 @@ func (s *K8sENOExecEventStorage) processEvent(event *types.ENOEXECInternalEvent)
 		return s.k8sClient.Status().Update(s.ctx, enoexecEvent)
 	})
 	if err != nil {
+		metrics.StorageFailures.WithLabelValues(metrics.OperationCreate).Inc()
 		rollbackFn()
 		return fmt.Errorf("failed to update ENOExecEvent status in Kubernetes: %w", err)
 	}
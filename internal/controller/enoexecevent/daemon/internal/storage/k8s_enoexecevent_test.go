@@ -10,6 +10,7 @@ import (
 	"golang.org/x/time/rate"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
 	storagetypes "github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
 )
 
@@ -157,6 +159,7 @@ func (w *simpleStatusWriter) Apply(ctx context.Context, obj runtime.ApplyConfigu
 
 func newTestStorage(t *testing.T, k8sClient client.Client) *K8sENOExecEventStorage {
 	t.Helper()
+	metrics.InitMetrics()
 	ctx := logr.NewContext(context.Background(), logr.Discard())
 	return &K8sENOExecEventStorage{
 		IWStorageBase: &IWStorageBase{
@@ -168,6 +171,9 @@ func newTestStorage(t *testing.T, k8sClient client.Client) *K8sENOExecEventStora
 		limiter:   rate.NewLimiter(rate.Inf, 1),
 		timeout:   10 * time.Second,
 		k8sClient: k8sClient,
+
+		aggregator:    newAggregator(time.Minute, maxAggregatedEvents),
+		flushInterval: time.Second,
 	}
 }
 
@@ -177,9 +183,11 @@ func TestProcessEvent_Success(t *testing.T) {
 	storage := newTestStorage(t, base)
 
 	event := &storagetypes.ENOEXECInternalEvent{
-		PodName:      "test-pod",
-		PodNamespace: "test-ns",
-		ContainerID:  "abc123",
+		PodName:                "test-pod",
+		PodNamespace:           "test-ns",
+		ContainerID:            "abc123",
+		Executable:             "/usr/bin/app",
+		ExecutableArchitecture: "amd64",
 	}
 
 	err := storage.processEvent(event)
@@ -204,6 +212,152 @@ func TestProcessEvent_Success(t *testing.T) {
 		if obj.Status.NodeName != "test-node" {
 			t.Errorf("expected NodeName 'test-node', got %q", obj.Status.NodeName)
 		}
+		if obj.Status.Executable != "/usr/bin/app" {
+			t.Errorf("expected Executable '/usr/bin/app', got %q", obj.Status.Executable)
+		}
+		if obj.Status.ExecutableArchitecture != "amd64" {
+			t.Errorf("expected ExecutableArchitecture 'amd64', got %q", obj.Status.ExecutableArchitecture)
+		}
+		if obj.Status.Count != 1 || obj.Status.FirstSeen == nil || obj.Status.LastSeen == nil {
+			t.Errorf("expected Count 1 and FirstSeen and LastSeen set, got %d, %v, %v",
+				obj.Status.Count, obj.Status.FirstSeen, obj.Status.LastSeen)
+		}
+	}
+}
+
+func TestProcessEvent_Aggregation(t *testing.T) {
+	store := newSimpleObjectStore()
+	base := &simpleClient{store: store, scheme: runtime.NewScheme()}
+	storage := newTestStorage(t, base)
+
+	event := &storagetypes.ENOEXECInternalEvent{
+		PodName:      "test-pod",
+		PodNamespace: "test-ns",
+		ContainerID:  "abc123",
+	}
+	for i := 0; i < 3; i++ {
+		if err := storage.processEvent(event); err != nil {
+			t.Fatalf("processEvent should succeed, got: %v", err)
+		}
+	}
+	if len(store.objects) != 1 {
+		t.Fatalf("expected the repeated events to be aggregated in 1 object, got %d", len(store.objects))
+	}
+
+	storage.flush()
+	for _, obj := range store.objects {
+		if obj.Status.Count != 3 {
+			t.Errorf("expected Count 3 after flush, got %d", obj.Status.Count)
+		}
+	}
+
+	// A flush without new errors does not write the object again
+	storage.flush()
+	if len(store.objects) != 1 {
+		t.Fatalf("expected no new object without new errors, got %d objects", len(store.objects))
+	}
+}
+
+func TestFlush_AfterHandlerConsumedTheObject(t *testing.T) {
+	event := &storagetypes.ENOEXECInternalEvent{
+		PodName:       "test-pod",
+		PodNamespace:  "test-ns",
+		ContainerID:   "cri-o://a",
+		ContainerName: "app",
+	}
+	tests := []struct {
+		name string
+		// consume simulates the reconciliation of the object by the handler.
+		consume func(store *simpleObjectStore, key types.NamespacedName)
+	}{
+		{
+			name: "deleted object",
+			consume: func(store *simpleObjectStore, key types.NamespacedName) {
+				delete(store.objects, key)
+			},
+		},
+		{
+			name: "retained object",
+			consume: func(store *simpleObjectStore, key types.NamespacedName) {
+				handledAt := metav1.Now()
+				store.objects[key].Status.Outcome = multiarchv1beta1.ENoExecEventOutcomePublished
+				store.objects[key].Status.HandledAt = &handledAt
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newSimpleObjectStore()
+			storage := newTestStorage(t, &simpleClient{store: store, scheme: runtime.NewScheme()})
+			for i := 0; i < 3; i++ {
+				if err := storage.processEvent(event); err != nil {
+					t.Fatalf("processEvent should succeed, got: %v", err)
+				}
+			}
+			storage.flush()
+			var consumed types.NamespacedName
+			for key := range store.objects {
+				consumed = key
+			}
+			tt.consume(store, consumed)
+
+			// The container restarts and fails again: only the new errors are written to a new object
+			restarted := *event
+			restarted.ContainerID = "cri-o://b"
+			if err := storage.processEvent(&restarted); err != nil {
+				t.Fatalf("processEvent should succeed, got: %v", err)
+			}
+			storage.flush()
+			var created *multiarchv1beta1.ENoExecEvent
+			for key, obj := range store.objects {
+				if key != consumed {
+					created = obj
+				}
+			}
+			if created == nil {
+				t.Fatalf("expected a new object for the new errors, got %d objects", len(store.objects))
+			}
+			if created.Status.Count != 1 || created.Status.ContainerID != "cri-o://b" {
+				t.Errorf("expected Count 1 for the container cri-o://b, got %d for %q",
+					created.Status.Count, created.Status.ContainerID)
+			}
+			if !created.Status.FirstSeen.Equal(created.Status.LastSeen) {
+				t.Errorf("expected FirstSeen to be the time of the new error, got %v, last seen %v",
+					created.Status.FirstSeen, created.Status.LastSeen)
+			}
+
+			// The flushes without new errors do not create other objects
+			storage.flush()
+			if want := len(store.objects); want > 2 {
+				t.Errorf("expected no other object, got %d objects", want)
+			}
+		})
+	}
+}
+
+func TestProcessEvent_RateLimited(t *testing.T) {
+	store := newSimpleObjectStore()
+	base := &simpleClient{store: store, scheme: runtime.NewScheme()}
+	storage := newTestStorage(t, base)
+	// A limiter without burst never lets an event through
+	storage.limiter = rate.NewLimiter(0, 0)
+
+	event := &storagetypes.ENOEXECInternalEvent{
+		PodName:      "test-pod",
+		PodNamespace: "test-ns",
+		ContainerID:  "abc123",
+	}
+
+	if err := storage.processEvent(event); err == nil {
+		t.Fatal("processEvent should fail when the rate limiter rejects the event")
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("expected no object in store, got %d", len(store.objects))
+	}
+	// The dropped event is not aggregated: the next one is written as a new object
+	if len(storage.aggregator.entries) != 0 {
+		t.Errorf("expected the dropped event to be forgotten by the aggregator, got %d entries",
+			len(storage.aggregator.entries))
 	}
 }
 
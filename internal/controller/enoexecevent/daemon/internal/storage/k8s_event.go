@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	goruntime "runtime"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	"github.com/go-logr/logr"
+
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// K8sEventStorage is a storage implementation that publishes the ENOEXEC events as core/v1 Events of the pods, or of
+// the node for the processes not running in a pod, without going through the ENoExecEvent objects and the handler.
+// The repeated events are aggregated by the event recorder.
+type K8sEventStorage struct {
+	*IWStorageBase
+	nodeName string
+	// nodeArch is the architecture of the node, reported in the messages of the events. The daemon runs natively on
+	// the node, so it is the architecture of the daemon binary.
+	nodeArch string
+	limiter  *rate.Limiter
+	timeout  time.Duration
+	recorder record.EventRecorder
+	// broadcaster is the broadcaster of the recorder, shut down when the storage stops. It is nil in the tests.
+	broadcaster record.EventBroadcaster
+}
+
+// NewK8sEventStorage creates a new K8sEventStorage instance, publishing the events as the ENoExecEvent daemon of the
+// given node.
+func NewK8sEventStorage(ctx context.Context, limiter *rate.Limiter, ch chan *types.ENOEXECInternalEvent, nodeName string,
+	timeout time.Duration) (*K8sEventStorage, error) {
+	log, err := logr.FromContext(ctx)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "Failed to get logger:", err)
+		return nil, fmt.Errorf("failed to get logger from context: %w", err)
+	}
+
+	log.Info("Starting K8sEventStorage")
+	config, err := controllerruntime.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Kubernetes clientset: %w", err)
+	}
+
+	broadcaster := record.NewBroadcaster(record.WithContext(ctx))
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	return &K8sEventStorage{
+		IWStorageBase: &IWStorageBase{
+			ctx: ctx,
+			ch:  ch,
+		},
+		nodeName:    nodeName,
+		nodeArch:    goruntime.GOARCH,
+		limiter:     limiter,
+		timeout:     timeout,
+		recorder:    broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: utils.EnoexecDaemonSet, Host: nodeName}),
+		broadcaster: broadcaster,
+	}, nil
+}
+
+// Run starts the K8sEventStorage event loop.
+// It listens for ENOEXEC events on the internal channel and publishes each of them as an event of its pod or node.
+func (s *K8sEventStorage) Run() error {
+	log, err := logr.FromContext(s.ctx)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "Failed to get logger:", err)
+		return fmt.Errorf("failed to get logger from context: %w", err)
+	}
+	defer utils.ShouldStdErr(s.close)
+	if s.broadcaster != nil {
+		defer s.broadcaster.Shutdown()
+	}
+
+	for {
+		select {
+		case event := <-s.ch:
+			if event == nil {
+				log.Info("Received nil event, skipping")
+				continue
+			}
+			if err = s.processEvent(event); err != nil {
+				log.Error(err, "Failed to process ENOExec event", "event", event)
+				continue
+			}
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+// processEvent publishes the event of an ENOEXECInternalEvent. As for the ENoExecEvent objects, the writes are
+// throttled and the events waiting for the rate limiter longer than the timeout are dropped.
+func (s *K8sEventStorage) processEvent(event *types.ENOEXECInternalEvent) error {
+	log := logr.FromContextOrDiscard(s.ctx)
+	ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
+	defer cancel()
+	if err := s.limiter.Wait(ctx); err != nil {
+		metrics.DroppedEvents.WithLabelValues(metrics.DroppedRateLimited).Inc()
+		return fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+
+	if event.PodName == "" {
+		log.Info("Publishing node event for ENOExec event", "node_name", s.nodeName,
+			"cgroup_path", event.CgroupPath, "command", event.Command)
+		s.recorder.Event(&corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Node",
+			Name:       s.nodeName,
+		}, corev1.EventTypeWarning, utils.ExecFormatErrorEventReason,
+			utils.HostExecFormatErrorEventMessage(event.Command, event.CgroupPath, s.nodeArch,
+				event.Executable, event.ExecutableArchitecture))
+		return nil
+	}
+
+	containerName := event.ContainerName
+	if containerName == "" {
+		containerName = utils.UnknownContainer
+	}
+	log.Info("Publishing pod event for ENOExec event", "pod_name", event.PodName,
+		"pod_namespace", event.PodNamespace, "container_id", event.ContainerID)
+	s.recorder.Event(&corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       event.PodName,
+		Namespace:  event.PodNamespace,
+	}, corev1.EventTypeWarning, utils.ExecFormatErrorEventReason,
+		utils.ExecFormatErrorEventMessage(containerName, s.nodeArch, event.Executable, event.ExecutableArchitecture))
+	return nil
+}
@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+
+	"k8s.io/client-go/tools/record"
+
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
+	storagetypes "github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+func newTestEventStorage(t *testing.T, recorder record.EventRecorder) *K8sEventStorage {
+	t.Helper()
+	metrics.InitMetrics()
+	return &K8sEventStorage{
+		IWStorageBase: &IWStorageBase{
+			ctx: logr.NewContext(context.Background(), logr.Discard()),
+			ch:  make(chan *storagetypes.ENOEXECInternalEvent, 10),
+		},
+		nodeName: "test-node",
+		nodeArch: utils.ArchitectureArm64,
+		limiter:  rate.NewLimiter(rate.Inf, 1),
+		timeout:  10 * time.Second,
+		recorder: recorder,
+	}
+}
+
+func TestK8sEventStorage_processEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		event *storagetypes.ENOEXECInternalEvent
+		want  string
+	}{
+		{
+			name: "pod event",
+			event: &storagetypes.ENOEXECInternalEvent{
+				PodName:                "test-pod",
+				PodNamespace:           "test-ns",
+				ContainerID:            "cri-o://abc123",
+				ContainerName:          "test-container",
+				Executable:             "/usr/bin/app",
+				ExecutableArchitecture: utils.ArchitectureAmd64,
+			},
+			want: "Warning " + utils.ExecFormatErrorEventReason + " " + utils.ExecFormatErrorEventMessage("test-container",
+				utils.ArchitectureArm64, "/usr/bin/app", utils.ArchitectureAmd64),
+		},
+		{
+			name: "pod event of an unknown container",
+			event: &storagetypes.ENOEXECInternalEvent{
+				PodName:      "test-pod",
+				PodNamespace: "test-ns",
+				ContainerID:  "cri-o://abc123",
+			},
+			want: "Warning " + utils.ExecFormatErrorEventReason + " " + utils.ExecFormatErrorEventMessage(utils.UnknownContainer,
+				utils.ArchitectureArm64, "", ""),
+		},
+		{
+			name: "node event",
+			event: &storagetypes.ENOEXECInternalEvent{
+				CgroupPath: "/system.slice/app.service",
+				Command:    "/usr/bin/app --flag",
+				Executable: "/usr/bin/app",
+			},
+			want: "Warning " + utils.ExecFormatErrorEventReason + " " + utils.HostExecFormatErrorEventMessage(
+				"/usr/bin/app --flag", "/system.slice/app.service", utils.ArchitectureArm64, "/usr/bin/app", ""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			storage := newTestEventStorage(t, recorder)
+			if err := storage.processEvent(tt.event); err != nil {
+				t.Fatalf("processEvent should succeed, got: %v", err)
+			}
+			select {
+			case got := <-recorder.Events:
+				if got != tt.want {
+					t.Errorf("expected event %q, got %q", tt.want, got)
+				}
+			default:
+				t.Fatal("expected an event to be recorded")
+			}
+		})
+	}
+}
+
+func TestK8sEventStorage_processEvent_RateLimited(t *testing.T) {
+	recorder := record.NewFakeRecorder(1)
+	storage := newTestEventStorage(t, recorder)
+	// A limiter without burst never lets an event through
+	storage.limiter = rate.NewLimiter(0, 0)
+
+	err := storage.processEvent(&storagetypes.ENOEXECInternalEvent{PodName: "test-pod", PodNamespace: "test-ns"})
+	if err == nil || !strings.Contains(err.Error(), "rate limiter") {
+		t.Fatalf("processEvent should fail on the rate limiter, got: %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("expected no event to be recorded, got %d", len(recorder.Events))
+	}
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/go-logr/logr"
+
+	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// logStorageMessage is the message of the JSON lines written by the LogStorage, for the log collectors to filter them.
+const logStorageMessage = "ENOEXEC event"
+
+// LogStorage is a storage implementation that writes the ENOEXEC events as JSON lines, one per event, e.g., to the
+// standard output of the daemon for the log collectors to ship them. No Kubernetes object is written.
+type LogStorage struct {
+	*IWStorageBase
+	nodeName string
+	encoder  *json.Encoder
+	now      func() time.Time
+}
+
+// logRecord is a JSON line written by the LogStorage. The fields of the event are the ones of the status of the
+// ENoExecEvent objects.
+type logRecord struct {
+	Time    metav1.Time `json:"time"`
+	Message string      `json:"msg"`
+	multiarchv1beta1.ENoExecEventStatus
+}
+
+// NewLogStorage creates a new LogStorage instance writing the events of the given node to out.
+func NewLogStorage(ctx context.Context, ch chan *types.ENOEXECInternalEvent, nodeName string, out io.Writer) (*LogStorage, error) {
+	log, err := logr.FromContext(ctx)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "Failed to get logger:", err)
+		return nil, fmt.Errorf("failed to get logger from context: %w", err)
+	}
+	log.Info("Starting LogStorage")
+	return &LogStorage{
+		IWStorageBase: &IWStorageBase{
+			ctx: ctx,
+			ch:  ch,
+		},
+		nodeName: nodeName,
+		encoder:  json.NewEncoder(out),
+		now:      time.Now,
+	}, nil
+}
+
+// Run starts the LogStorage event loop.
+// It listens for ENOEXEC events on the internal channel and writes each of them as a JSON line.
+func (s *LogStorage) Run() error {
+	log, err := logr.FromContext(s.ctx)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "Failed to get logger:", err)
+		return fmt.Errorf("failed to get logger from context: %w", err)
+	}
+	defer utils.ShouldStdErr(s.close)
+
+	for {
+		select {
+		case event := <-s.ch:
+			if event == nil {
+				log.Info("Received nil event, skipping")
+				continue
+			}
+			if err = s.processEvent(event); err != nil {
+				log.Error(err, "Failed to process ENOExec event", "event", event)
+				continue
+			}
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+// processEvent writes the JSON line of an ENOEXECInternalEvent.
+func (s *LogStorage) processEvent(event *types.ENOEXECInternalEvent) error {
+	enoexecEvent, err := event.ToENoExecEvent("", s.nodeName)
+	if err != nil {
+		return err
+	}
+	if err = s.encoder.Encode(&logRecord{
+		Time:               metav1.NewTime(s.now()),
+		Message:            logStorageMessage,
+		ENoExecEventStatus: enoexecEvent.Status,
+	}); err != nil {
+		return fmt.Errorf("failed to write the ENOExec event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	storagetypes "github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
+)
+
+func TestLogStorage_processEvent(t *testing.T) {
+	out := &bytes.Buffer{}
+	storage, err := NewLogStorage(logr.NewContext(context.Background(), logr.Discard()),
+		make(chan *storagetypes.ENOEXECInternalEvent, 10), "test-node", out)
+	if err != nil {
+		t.Fatalf("NewLogStorage() error = %v", err)
+	}
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	storage.now = func() time.Time { return now }
+
+	events := []*storagetypes.ENOEXECInternalEvent{
+		{
+			PodName:                "test-pod",
+			PodNamespace:           "test-ns",
+			ContainerID:            "cri-o://abc123",
+			ContainerName:          "test-container",
+			Executable:             "/usr/bin/app",
+			ExecutableArchitecture: "amd64",
+		},
+		{
+			CgroupPath: "/system.slice/app.service",
+			Command:    "/usr/bin/app",
+		},
+	}
+	for _, event := range events {
+		if err := storage.processEvent(event); err != nil {
+			t.Fatalf("processEvent should succeed, got: %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != len(events) {
+		t.Fatalf("expected %d lines, got %d: %q", len(events), len(lines), out.String())
+	}
+	want := []map[string]string{
+		{
+			"time": "2025-01-02T03:04:05Z", "msg": logStorageMessage, "nodeName": "test-node", "podName": "test-pod",
+			"podNamespace": "test-ns", "containerID": "cri-o://abc123", "executable": "/usr/bin/app",
+			"executableArchitecture": "amd64",
+		},
+		{
+			"time": "2025-01-02T03:04:05Z", "msg": logStorageMessage, "nodeName": "test-node",
+			"cgroupPath": "/system.slice/app.service", "command": "/usr/bin/app",
+		},
+	}
+	for i, line := range lines {
+		got := map[string]string{}
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("line %d is not a JSON object of strings: %v: %q", i, err, line)
+		}
+		if len(got) != len(want[i]) {
+			t.Errorf("line %d: expected the fields %v, got %v", i, want[i], got)
+		}
+		for key, value := range want[i] {
+			if got[key] != value {
+				t.Errorf("line %d: expected %s=%q, got %q", i, key, value, got[key])
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package tracepoint
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// cgroupContainer is the container of a process, as read from its cgroup path.
+type cgroupContainer struct {
+	// podUID is the UID of the pod, in the dash-separated format of the Kubernetes API.
+	podUID string
+	// containerID is the 64-character hexadecimal ID of the container, without the runtime prefix.
+	containerID string
+	// runtime is the name of the container runtime, in the format of the runtime prefix of the container IDs in the
+	// pod status (e.g., cri-o, containerd or docker). It is empty if the cgroup path does not name the runtime, as with
+	// the cgroupfs cgroup driver.
+	runtime string
+}
+
+// cgroupContainerRegexp matches the cgroup paths of the containers of the pods, with the systemd and cgroupfs cgroup
+// drivers. The submatches are the pod UID, the scope prefix naming the runtime (systemd driver only) and the
+// container ID. Examples:
+// - CRI-O, systemd driver:
+// /kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/crio-4015cec33e493690b48385efa86af20938e5b80077094cbc5e875945178d57be.scope
+// - containerd, systemd driver:
+// /kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/cri-containerd-4015cec33e493690b48385efa86af20938e5b80077094cbc5e875945178d57be.scope
+// - cgroupfs driver:
+// /kubepods/burstable/pod15778196-6e1f-4b5d-8012-12c7bf5c04b3/4015cec33e493690b48385efa86af20938e5b80077094cbc5e875945178d57be
+// Nested cgroups below the container one are matched as well. The static pods are matched by the 32-character hash
+// used as their UID.
+var cgroupContainerRegexp = regexp.MustCompile(
+	`pod([0-9a-fA-F]{8}[-_][0-9a-fA-F]{4}[-_][0-9a-fA-F]{4}[-_][0-9a-fA-F]{4}[-_][0-9a-fA-F]{12}|[0-9a-f]{32})(?:\.slice)?/` +
+		`(?:(crio|cri-containerd|docker)-)?([0-9a-f]{64})(?:\.scope)?(?:/.*)?$`)
+
+// scopeRuntimes maps the prefixes of the systemd scopes of the containers to the runtime prefixes of the container IDs.
+var scopeRuntimes = map[string]string{
+	"crio":           "cri-o",
+	"cri-containerd": "containerd",
+	"docker":         "docker",
+}
+
+// readCgroup returns the lines of the cgroup file of the process with the given pid.
+func readCgroup(pid uint32) ([]string, error) {
+	cgroupPath := fmt.Sprintf("/proc/%d/cgroup", pid)
+	//#nosec:G304 (CWE-22): Potential file inclusion via variable (Confidence: HIGH, Severity: MEDIUM)
+	file, err := os.Open(cgroupPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", cgroupPath, err)
+	}
+	defer utils.ShouldStdErr(file.Close)
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", cgroupPath, err)
+	}
+	return lines, nil
+}
+
+// parseCgroup returns the container from the lines of a /proc/<pid>/cgroup file.
+// Each line has the format hierarchy-ID:controller-list:cgroup-path. With cgroup v2, there is a single line
+// 0::<cgroup-path>. With cgroup v1, the first line whose path is the one of a container is used.
+func parseCgroup(lines []string) (*cgroupContainer, error) {
+	for _, line := range lines {
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		match := cgroupContainerRegexp.FindStringSubmatch(fields[2])
+		if match == nil {
+			continue
+		}
+		// match[0] is the full match, match[1] is the pod UID, match[2] is the scope prefix and match[3] is the
+		// container ID.
+		return &cgroupContainer{
+			podUID:      strings.ReplaceAll(match[1], "_", "-"),
+			containerID: match[3],
+			runtime:     scopeRuntimes[match[2]],
+		}, nil
+	}
+	return nil, fmt.Errorf("no container cgroup found in %q", strings.Join(lines, "\n"))
+}
+
+// cgroupPathOf returns the cgroup path from the lines of a /proc/<pid>/cgroup file: the path in the unified hierarchy
+// with cgroup v2, the path in the systemd hierarchy with cgroup v1, and the path of the first line otherwise.
+func cgroupPathOf(lines []string) string {
+	first := ""
+	for _, line := range lines {
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" || fields[1] == "name=systemd" {
+			return fields[2]
+		}
+		if first == "" {
+			first = fields[2]
+		}
+	}
+	return first
+}
+
+// isHostCgroup returns true if the cgroup path of the lines of a /proc/<pid>/cgroup file is outside the kubepods
+// hierarchy, i.e., the process does not run in a pod. The processes of the pods whose cgroup path is not parsed, e.g.,
+// the pause containers or the runtime monitors, are not host processes.
+func isHostCgroup(lines []string) bool {
+	cgroupPath := cgroupPathOf(lines)
+	if cgroupPath == "" {
+		return false
+	}
+	for _, component := range strings.Split(cgroupPath, "/") {
+		// The kubelet-kubepods.slice is the root slice of the pods with a kubelet.slice parent, e.g., in kind.
+		if component == "kubepods" || component == "kubepods.slice" || strings.HasSuffix(component, "-kubepods.slice") {
+			return false
+		}
+	}
+	return true
+}
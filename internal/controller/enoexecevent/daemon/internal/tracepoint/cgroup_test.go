@@ -0,0 +1,171 @@
+package tracepoint
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	testContainerID = "4015cec33e493690b48385efa86af20938e5b80077094cbc5e875945178d57be"
+	testPodUID      = "15778196-6e1f-4b5d-8012-12c7bf5c04b3"
+)
+
+func TestParseCgroup(t *testing.T) {
+	tests := []struct {
+		name    string
+		lines   []string
+		want    *cgroupContainer
+		wantErr bool
+	}{
+		{
+			name: "CRI-O with the systemd cgroup driver",
+			lines: []string{"0::/kubepods.slice/kubepods-besteffort.slice/" +
+				"kubepods-besteffort-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/crio-" + testContainerID + ".scope"},
+			want: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: "cri-o"},
+		},
+		{
+			name: "containerd with the systemd cgroup driver",
+			lines: []string{"0::/kubepods.slice/kubepods-burstable.slice/" +
+				"kubepods-burstable-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/cri-containerd-" + testContainerID + ".scope"},
+			want: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: "containerd"},
+		},
+		{
+			name: "containerd in a kind node with the systemd cgroup driver",
+			lines: []string{"0::/kubelet.slice/kubelet-kubepods.slice/kubelet-kubepods-besteffort.slice/" +
+				"kubelet-kubepods-besteffort-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/cri-containerd-" + testContainerID + ".scope"},
+			want: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: "containerd"},
+		},
+		{
+			name: "docker with the systemd cgroup driver",
+			lines: []string{"0::/kubepods.slice/kubepods-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/docker-" +
+				testContainerID + ".scope"},
+			want: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: "docker"},
+		},
+		{
+			name:  "cgroupfs cgroup driver",
+			lines: []string{"0::/kubepods/burstable/pod" + testPodUID + "/" + testContainerID},
+			want:  &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: ""},
+		},
+		{
+			name:  "cgroupfs cgroup driver for a guaranteed pod",
+			lines: []string{"0::/kubepods/pod" + testPodUID + "/" + testContainerID},
+			want:  &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: ""},
+		},
+		{
+			name: "static pod",
+			lines: []string{"0::/kubepods.slice/kubepods-burstable.slice/" +
+				"kubepods-burstable-pod8c1b2f7b4c2d4e6a9f0e1d2c3b4a5f6e.slice/crio-" + testContainerID + ".scope"},
+			want: &cgroupContainer{podUID: "8c1b2f7b4c2d4e6a9f0e1d2c3b4a5f6e", containerID: testContainerID, runtime: "cri-o"},
+		},
+		{
+			name: "cgroup v1",
+			lines: []string{
+				"12:pids:/system.slice/sshd.service",
+				"11:memory:/kubepods/besteffort/pod" + testPodUID + "/" + testContainerID,
+				"1:name=systemd:/kubepods/besteffort/pod" + testPodUID + "/" + testContainerID,
+			},
+			want: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: ""},
+		},
+		{
+			name: "nested cgroup of the container",
+			lines: []string{"0::/kubepods.slice/kubepods-besteffort.slice/" +
+				"kubepods-besteffort-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/crio-" + testContainerID + ".scope/init.scope"},
+			want: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: "cri-o"},
+		},
+		{
+			name: "CRI-O conmon process",
+			lines: []string{"0::/kubepods.slice/kubepods-besteffort.slice/" +
+				"kubepods-besteffort-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/crio-conmon-" + testContainerID + ".scope"},
+			wantErr: true,
+		},
+		{
+			name:    "host process",
+			lines:   []string{"0::/system.slice/kubelet.service"},
+			wantErr: true,
+		},
+		{
+			name:    "empty cgroup file",
+			lines:   []string{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCgroup(tt.lines)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCgroup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCgroup() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCgroupPathOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{
+			name:  "cgroup v2",
+			lines: []string{"0::/system.slice/kubelet.service"},
+			want:  "/system.slice/kubelet.service",
+		},
+		{
+			name: "cgroup v1",
+			lines: []string{
+				"12:pids:/system.slice/sshd.service",
+				"11:memory:/system.slice/sshd.service",
+				"1:name=systemd:/system.slice/sshd.service/session",
+			},
+			want: "/system.slice/sshd.service/session",
+		},
+		{
+			name:  "cgroup v1 without the systemd hierarchy",
+			lines: []string{"malformed", "12:pids:/user.slice", "11:memory:/"},
+			want:  "/user.slice",
+		},
+		{
+			name:  "empty cgroup file",
+			lines: []string{},
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cgroupPathOf(tt.lines); got != tt.want {
+				t.Errorf("cgroupPathOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsHostCgroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  bool
+	}{
+		{name: "systemd service", lines: []string{"0::/system.slice/kubelet.service"}, want: true},
+		{name: "user session", lines: []string{"0::/user.slice/user-1000.slice/session-1.scope"}, want: true},
+		{name: "pod sandbox, systemd driver",
+			lines: []string{"0::/kubepods.slice/kubepods-besteffort.slice/" +
+				"kubepods-besteffort-pod15778196_6e1f_4b5d_8012_12c7bf5c04b3.slice/crio-conmon-" + testContainerID + ".scope"},
+			want: false},
+		{name: "pod, cgroupfs driver", lines: []string{"0::/kubepods/burstable/pod" + testPodUID}, want: false},
+		{name: "pod with a kubelet slice parent",
+			lines: []string{"0::/kubelet.slice/kubelet-kubepods.slice/kubelet-kubepods-besteffort.slice"}, want: false},
+		{name: "cgroup v1 pod",
+			lines: []string{"12:pids:/kubepods/besteffort", "1:name=systemd:/kubepods/besteffort"}, want: false},
+		{name: "empty cgroup file", lines: []string{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHostCgroup(tt.lines); got != tt.want {
+				t.Errorf("isHostCgroup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
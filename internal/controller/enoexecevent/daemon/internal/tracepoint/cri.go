@@ -0,0 +1,133 @@
+package tracepoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// HostRunPath is the path where the directories of the CRI sockets in the /run directory of the host are mounted in
+// the ENoExecEvent daemon pods.
+const HostRunPath = "/host/run"
+
+// DefaultCRISockets is the list of the unix sockets of the CRI runtimes looked up by the ENoExecEvent daemon when no
+// socket is configured. The first existing socket is used. The cri-dockerd socket, created directly in the /run
+// directory of the host, must be configured.
+var DefaultCRISockets = []string{
+	HostRunPath + "/crio/crio.sock",
+	HostRunPath + "/containerd/containerd.sock",
+	HostRunPath + "/k3s/containerd/containerd.sock",
+}
+
+// kubernetesPodUIDLabel is the label set by the kubelet on the pod sandboxes to store the UID of the pod.
+const kubernetesPodUIDLabel = "io.kubernetes.pod.uid"
+
+// criClient is a client of the CRI runtime service, used to get the pods and the name of the runtime.
+type criClient struct {
+	socket string
+	conn   *grpc.ClientConn
+	client runtimeapi.RuntimeServiceClient
+	// runtimeName is the name of the runtime, cached after the first successful Version call.
+	runtimeName string
+}
+
+// newCRIClient creates a client of the CRI runtime listening at the given unix socket. If the socket is empty, the
+// first existing socket in DefaultCRISockets is used.
+// The connection is established lazily, at the first call.
+func newCRIClient(socket string) (*criClient, error) {
+	if socket == "" {
+		var err error
+		if socket, err = detectCRISocket(DefaultCRISockets); err != nil {
+			return nil, err
+		}
+	}
+	conn, err := grpc.NewClient("unix://"+socket,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the CRI socket %s: %w", socket, err)
+	}
+	return &criClient{
+		socket: socket,
+		conn:   conn,
+		client: runtimeapi.NewRuntimeServiceClient(conn),
+	}, nil
+}
+
+// detectCRISocket returns the first path in the candidates that is a unix socket.
+func detectCRISocket(candidates []string) (string, error) {
+	for _, candidate := range candidates {
+		if info, err := os.Stat(candidate); err == nil && info.Mode()&os.ModeSocket != 0 {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no CRI socket found in %v", candidates)
+}
+
+func (c *criClient) close() error {
+	return c.conn.Close()
+}
+
+// getPodNameFromUID retrieves the pod name and namespace from the CRI runtime using the pod UID.
+// If the pod is not found, it returns empty strings for both name and namespace without an error.
+// It returns an error if the connection to the CRI socket fails or if other critical operations fail.
+func (c *criClient) getPodNameFromUID(ctx context.Context, uid string) (string, string, error) {
+	now := time.Now()
+	pods, err := c.client.ListPodSandbox(ctx, &runtimeapi.ListPodSandboxRequest{
+		Filter: &runtimeapi.PodSandboxFilter{
+			LabelSelector: map[string]string{kubernetesPodUIDLabel: uid},
+		},
+	})
+	utils.HistogramObserve(now, metrics.TimeToQueryCRI)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to list pod sandboxes: %w", err)
+	}
+	for _, pod := range pods.GetItems() {
+		if pod.GetMetadata().GetUid() == uid {
+			return pod.Metadata.Name, pod.Metadata.Namespace, nil
+		}
+	}
+	return "", "", nil
+}
+
+// getRuntimeName returns the name of the CRI runtime, used by the kubelet as the prefix of the container IDs in the
+// pod status (e.g., cri-o, containerd or docker).
+func (c *criClient) getRuntimeName(ctx context.Context) (string, error) {
+	if c.runtimeName != "" {
+		return c.runtimeName, nil
+	}
+	now := time.Now()
+	version, err := c.client.Version(ctx, &runtimeapi.VersionRequest{})
+	utils.HistogramObserve(now, metrics.TimeToQueryCRI)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the CRI runtime version: %w", err)
+	}
+	if version.GetRuntimeName() == "" {
+		return "", errors.New("the CRI runtime returned an empty runtime name")
+	}
+	c.runtimeName = version.GetRuntimeName()
+	return c.runtimeName, nil
+}
+
+// containerIDFor returns the ID of the container, prefixed with the name of the runtime as in the pod status, e.g.,
+// containerd://<container-id>. The runtime named in the cgroup path is used if any, otherwise the CRI runtime is
+// queried for its name.
+func (c *criClient) containerIDFor(ctx context.Context, container *cgroupContainer) (string, error) {
+	runtime := container.runtime
+	if runtime == "" {
+		var err error
+		if runtime, err = c.getRuntimeName(ctx); err != nil {
+			return "", err
+		}
+	}
+	return runtime + "://" + container.containerID, nil
+}
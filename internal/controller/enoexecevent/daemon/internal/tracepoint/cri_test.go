@@ -0,0 +1,141 @@
+package tracepoint
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
+)
+
+// fakeRuntimeService is a CRI runtime service serving a single pod sandbox.
+type fakeRuntimeService struct {
+	runtimeapi.UnimplementedRuntimeServiceServer
+	runtimeName string
+	versionCall int
+}
+
+func (f *fakeRuntimeService) Version(_ context.Context, _ *runtimeapi.VersionRequest) (*runtimeapi.VersionResponse, error) {
+	f.versionCall++
+	return &runtimeapi.VersionResponse{RuntimeName: f.runtimeName}, nil
+}
+
+func (f *fakeRuntimeService) ListPodSandbox(_ context.Context, req *runtimeapi.ListPodSandboxRequest) (*runtimeapi.ListPodSandboxResponse, error) {
+	if req.GetFilter().GetLabelSelector()[kubernetesPodUIDLabel] != testPodUID {
+		return &runtimeapi.ListPodSandboxResponse{}, nil
+	}
+	return &runtimeapi.ListPodSandboxResponse{Items: []*runtimeapi.PodSandbox{
+		{Metadata: &runtimeapi.PodSandboxMetadata{Name: "test-pod", Namespace: "test-namespace", Uid: testPodUID}},
+	}}, nil
+}
+
+// startFakeRuntimeService serves the fake runtime service on a unix socket in a temporary directory and returns the
+// path of the socket.
+func startFakeRuntimeService(t *testing.T, service *fakeRuntimeService) string {
+	metrics.InitMetrics()
+	// The unix socket paths are limited to 108 characters: t.TempDir() can be too long
+	dir, err := os.MkdirTemp("", "cri")
+	if err != nil {
+		t.Fatalf("failed to create the socket directory: %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "cri.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("failed to listen on %s: %v", socket, err)
+	}
+	server := grpc.NewServer()
+	runtimeapi.RegisterRuntimeServiceServer(server, service)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	return socket
+}
+
+func TestDetectCRISocket(t *testing.T) {
+	socket := startFakeRuntimeService(t, &fakeRuntimeService{})
+	notASocket := filepath.Join(t.TempDir(), "containerd.sock")
+	if err := os.WriteFile(notASocket, nil, 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", notASocket, err)
+	}
+	tests := []struct {
+		name       string
+		candidates []string
+		want       string
+		wantErr    bool
+	}{
+		{name: "first existing socket", candidates: []string{"/not/found.sock", notASocket, socket}, want: socket},
+		{name: "no socket", candidates: []string{"/not/found.sock", notASocket}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := detectCRISocket(tt.candidates)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("detectCRISocket() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("detectCRISocket() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCRIClient(t *testing.T) {
+	service := &fakeRuntimeService{runtimeName: "containerd"}
+	cri, err := newCRIClient(startFakeRuntimeService(t, service))
+	if err != nil {
+		t.Fatalf("newCRIClient() error = %v", err)
+	}
+	defer func() { _ = cri.close() }()
+	ctx := context.Background()
+
+	name, namespace, err := cri.getPodNameFromUID(ctx, testPodUID)
+	if err != nil || name != "test-pod" || namespace != "test-namespace" {
+		t.Errorf("getPodNameFromUID() = (%q, %q, %v), want (test-pod, test-namespace, nil)", name, namespace, err)
+	}
+	name, namespace, err = cri.getPodNameFromUID(ctx, "not-found")
+	if err != nil || name != "" || namespace != "" {
+		t.Errorf("getPodNameFromUID() = (%q, %q, %v), want empty strings and no error", name, namespace, err)
+	}
+
+	tests := []struct {
+		name      string
+		container *cgroupContainer
+		want      string
+	}{
+		{
+			name:      "runtime from the cgroup path",
+			container: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: "cri-o"},
+			want:      "cri-o://" + testContainerID,
+		},
+		{
+			name:      "runtime from the CRI runtime",
+			container: &cgroupContainer{podUID: testPodUID, containerID: testContainerID},
+			want:      "containerd://" + testContainerID,
+		},
+		{
+			name:      "cached runtime from the CRI runtime",
+			container: &cgroupContainer{podUID: testPodUID, containerID: testContainerID},
+			want:      "containerd://" + testContainerID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cri.containerIDFor(ctx, tt.container)
+			if err != nil {
+				t.Fatalf("containerIDFor() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("containerIDFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if service.versionCall != 1 {
+		t.Errorf("the runtime name was requested %d times, want 1", service.versionCall)
+	}
+}
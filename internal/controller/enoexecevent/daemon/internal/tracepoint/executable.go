@@ -0,0 +1,110 @@
+package tracepoint
+
+import (
+	"bytes"
+	"debug/elf"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// filenameFromRecord returns the NUL-terminated filename copied by the eBPF program into the record.
+func filenameFromRecord(raw []byte) string {
+	if i := bytes.IndexByte(raw, 0); i >= 0 {
+		raw = raw[:i]
+	}
+	return string(raw)
+}
+
+// readExecutableArchitecture returns the architecture of the executable, read from the e_machine field of its ELF
+// header. The executable is resolved in the filesystem of the first pid for which it can be opened: the absolute paths
+// are resolved from /proc/<pid>/root, the relative ones from /proc/<pid>/cwd.
+// It returns an empty string, with no error, if the file is not an ELF binary (e.g., a script with a broken shebang).
+func readExecutableArchitecture(executable string, pids ...uint32) (string, error) {
+	if executable == "" {
+		return "", errors.New("the executable is unknown")
+	}
+	errs := make([]error, 0, len(pids))
+	for _, pid := range pids {
+		file, err := openInProcessRoot(pid, executable)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return elfArchitecture(file)
+	}
+	return "", errors.Join(errs...)
+}
+
+// openInProcessRoot opens the file at path in the mount namespace of the process with the given pid.
+// The path is resolved with openat2 and RESOLVE_IN_ROOT from /proc/<pid>/root, so that the symlinks and the ..
+// components of the container filesystem cannot escape its root. The relative paths, e.g., ../bin/app, are first
+// joined to the working directory of the process, read from /proc/<pid>/cwd.
+func openInProcessRoot(pid uint32, path string) (*os.File, error) {
+	if !filepath.IsAbs(path) {
+		cwd, err := os.Readlink(fmt.Sprintf("/proc/%d/cwd", pid))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the working directory of %d: %w", pid, err)
+		}
+		if !filepath.IsAbs(cwd) {
+			// The working directory is not reachable from the root of the process, e.g., "(unreachable)/app".
+			return nil, fmt.Errorf("the working directory of %d is not reachable: %s", pid, cwd)
+		}
+		// The path is not cleaned, so that the .. components are resolved by the kernel after the symlinks.
+		path = cwd + "/" + path
+	}
+	dir := fmt.Sprintf("/proc/%d/root", pid)
+	dirFd, err := unix.Open(dir, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", dir, err)
+	}
+	defer utils.ShouldStdErr(func() error { return unix.Close(dirFd) })
+	fd, err := unix.Openat2(dirFd, path, &unix.OpenHow{
+		Flags:   unix.O_RDONLY | unix.O_CLOEXEC,
+		Resolve: unix.RESOLVE_IN_ROOT | unix.RESOLVE_NO_MAGICLINKS,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s in %s: %w", path, dir, err)
+	}
+	return os.NewFile(uintptr(fd), filepath.Join(dir, path)), nil
+}
+
+// elfArchitecture returns the architecture of the ELF binary and closes the file. It uses the GOARCH naming of the
+// kubernetes.io/arch node label. The architectures not supported by the operator are returned as the name of the ELF
+// machine (e.g., EM_MIPS). It returns an empty string if the file is not an ELF binary.
+func elfArchitecture(file *os.File) (string, error) {
+	defer utils.ShouldStdErr(file.Close)
+	elfFile, err := elf.NewFile(file)
+	if err != nil {
+		var formatErr *elf.FormatError
+		if errors.As(err, &formatErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read the ELF header of %s: %w", file.Name(), err)
+	}
+	switch elfFile.Machine {
+	case elf.EM_X86_64:
+		return utils.ArchitectureAmd64, nil
+	case elf.EM_AARCH64:
+		return utils.ArchitectureArm64, nil
+	case elf.EM_PPC64:
+		if elfFile.Data == elf.ELFDATA2LSB {
+			return utils.ArchitecturePpc64le, nil
+		}
+		return "ppc64", nil
+	case elf.EM_S390:
+		return utils.ArchitectureS390x, nil
+	case elf.EM_386:
+		return "386", nil
+	case elf.EM_ARM:
+		return "arm", nil
+	default:
+		return elfFile.Machine.String(), nil
+	}
+}
@@ -0,0 +1,68 @@
+package tracepoint
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestFilenameFromRecord(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  []byte
+		want string
+	}{
+		{name: "NUL-terminated filename", raw: append([]byte("/usr/bin/app"), 0, 0, 'x'), want: "/usr/bin/app"},
+		{name: "truncated filename", raw: []byte("/usr/bin/app"), want: "/usr/bin/app"},
+		{name: "empty filename", raw: make([]byte, FilenameSize), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filenameFromRecord(tt.raw); got != tt.want {
+				t.Errorf("filenameFromRecord() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadExecutableArchitecture(t *testing.T) {
+	testBinary, err := os.Executable()
+	if err != nil {
+		t.Fatalf("failed to get the test binary path: %v", err)
+	}
+	script := filepath.Join(t.TempDir(), "script")
+	if err := os.WriteFile(script, []byte("echo hello\n"), 0600); err != nil {
+		t.Fatalf("failed to write the script: %v", err)
+	}
+	pid := uint32(os.Getpid()) // #nosec G115 -- the pid fits in an uint32
+	tests := []struct {
+		name       string
+		executable string
+		pids       []uint32
+		want       string
+		wantErr    bool
+	}{
+		{name: "ELF binary", executable: testBinary, pids: []uint32{pid}, want: runtime.GOARCH},
+		{name: "ELF binary after a pid not found", executable: testBinary, pids: []uint32{0, pid}, want: runtime.GOARCH},
+		{name: "not an ELF binary", executable: script, pids: []uint32{pid}, want: ""},
+		{name: "relative path", executable: "executable.go", pids: []uint32{pid}, want: ""},
+		{name: "relative path to a parent directory", executable: "../tracepoint/executable.go",
+			pids: []uint32{pid}, want: ""},
+		{name: "relative path escaping the root", executable: "../../../../../../../../../../../../../" + testBinary,
+			pids: []uint32{pid}, want: runtime.GOARCH},
+		{name: "file not found", executable: "/not/found", pids: []uint32{pid}, wantErr: true},
+		{name: "unknown executable", executable: "", pids: []uint32{pid}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readExecutableArchitecture(tt.executable, tt.pids...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readExecutableArchitecture() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("readExecutableArchitecture() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
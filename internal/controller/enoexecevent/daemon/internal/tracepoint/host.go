@@ -0,0 +1,42 @@
+package tracepoint
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// MaxCommandLength is the maximum length of the command lines reported in the node-scoped ENoExecEvents.
+const MaxCommandLength = 255
+
+// readCommand returns the command line of the process with the given pid, with the arguments separated by spaces and
+// truncated to MaxCommandLength characters. The name of the process is returned for the processes without a command
+// line, e.g., the kernel threads.
+func readCommand(pid uint32) (string, error) {
+	//#nosec:G304 (CWE-22): Potential file inclusion via variable (Confidence: HIGH, Severity: MEDIUM)
+	cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the command line of the process %d: %w", pid, err)
+	}
+	command := commandFromCmdline(cmdline)
+	if command == "" {
+		//#nosec:G304 (CWE-22): Potential file inclusion via variable (Confidence: HIGH, Severity: MEDIUM)
+		comm, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", pid))
+		if err != nil {
+			return "", fmt.Errorf("failed to read the name of the process %d: %w", pid, err)
+		}
+		command = strings.TrimSpace(string(comm))
+	}
+	return command, nil
+}
+
+// commandFromCmdline converts the NUL-separated arguments of a /proc/<pid>/cmdline file to a command line, truncated to
+// MaxCommandLength characters.
+func commandFromCmdline(cmdline []byte) string {
+	command := strings.TrimSpace(string(bytes.ReplaceAll(bytes.TrimRight(cmdline, "\x00"), []byte{0}, []byte{' '})))
+	if len(command) > MaxCommandLength {
+		command = command[:MaxCommandLength]
+	}
+	return command
+}
@@ -0,0 +1,38 @@
+package tracepoint
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCommandFromCmdline(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmdline []byte
+		want    string
+	}{
+		{name: "arguments", cmdline: []byte("/usr/bin/my-agent\x00--serve\x00--port=8080\x00"), want: "/usr/bin/my-agent --serve --port=8080"},
+		{name: "no trailing NUL", cmdline: []byte("/bin/sh\x00-c\x00true"), want: "/bin/sh -c true"},
+		{name: "kernel thread", cmdline: []byte{}, want: ""},
+		{name: "truncated", cmdline: []byte(strings.Repeat("a", MaxCommandLength+10)), want: strings.Repeat("a", MaxCommandLength)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commandFromCmdline(tt.cmdline); got != tt.want {
+				t.Errorf("commandFromCmdline() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCommand(t *testing.T) {
+	pid := uint32(os.Getpid()) // #nosec G115 -- pids are positive and fit in 32 bits
+	got, err := readCommand(pid)
+	if err != nil {
+		t.Fatalf("readCommand() error = %v", err)
+	}
+	if !strings.HasPrefix(got, os.Args[0]) {
+		t.Errorf("readCommand() = %q, want the command line of the test starting with %q", got, os.Args[0])
+	}
+}
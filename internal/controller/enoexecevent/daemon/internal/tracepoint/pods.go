@@ -0,0 +1,202 @@
+package tracepoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// podUIDIndex is the name of the index of the pods by UID in the cache of the pods of the node.
+// The mirror pods of the static pods are indexed by the UID of their static pod as well, as it is the one in the cgroup
+// paths of their containers.
+const podUIDIndex = "uid"
+
+// NewNodePodInformer returns an informer of the pods scheduled to the given node, indexed by UID.
+// The cached pods only keep the fields used to resolve the ENOEXEC events: the name, namespace, UID and mirror
+// annotation of the pods and the IDs of their containers.
+func NewNodePodInformer(clientset kubernetes.Interface, nodeName string, resync time.Duration) (cache.SharedIndexInformer, error) {
+	factory := informers.NewSharedInformerFactoryWithOptions(clientset, resync,
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("spec.nodeName", nodeName).String()
+		}))
+	informer := factory.Core().V1().Pods().Informer()
+	if err := informer.AddIndexers(cache.Indexers{podUIDIndex: podUIDIndexFunc}); err != nil {
+		return nil, fmt.Errorf("failed to add the pod UID indexer: %w", err)
+	}
+	if err := informer.SetTransform(trimPod); err != nil {
+		return nil, fmt.Errorf("failed to set the pod transform: %w", err)
+	}
+	return informer, nil
+}
+
+func podUIDIndexFunc(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object of type %T in the pod cache", obj)
+	}
+	if mirror, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok && mirror != "" && mirror != string(pod.UID) {
+		return []string{string(pod.UID), mirror}, nil
+	}
+	return []string{string(pod.UID)}, nil
+}
+
+// trimPod drops the fields of the pods that are not used to resolve the ENOEXEC events, to reduce the memory
+// footprint of the cache. Objects other than pods, e.g., the tombstones of the deleted ones, are returned unchanged.
+func trimPod(obj interface{}) (interface{}, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return obj, nil
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pod.Name,
+			Namespace:       pod.Namespace,
+			UID:             pod.UID,
+			ResourceVersion: pod.ResourceVersion,
+			Annotations:     mirrorAnnotation(pod),
+		},
+		Spec: corev1.PodSpec{
+			NodeName: pod.Spec.NodeName,
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses:      trimContainerStatuses(pod.Status.InitContainerStatuses),
+			ContainerStatuses:          trimContainerStatuses(pod.Status.ContainerStatuses),
+			EphemeralContainerStatuses: trimContainerStatuses(pod.Status.EphemeralContainerStatuses),
+		},
+	}, nil
+}
+
+func mirrorAnnotation(pod *corev1.Pod) map[string]string {
+	mirror, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]
+	if !ok {
+		return nil
+	}
+	return map[string]string{corev1.MirrorPodAnnotationKey: mirror}
+}
+
+func trimContainerStatuses(statuses []corev1.ContainerStatus) []corev1.ContainerStatus {
+	if statuses == nil {
+		return nil
+	}
+	trimmed := make([]corev1.ContainerStatus, 0, len(statuses))
+	for _, status := range statuses {
+		trimmed = append(trimmed, corev1.ContainerStatus{
+			Name:        status.Name,
+			ContainerID: status.ContainerID,
+		})
+	}
+	return trimmed
+}
+
+// podResolver resolves the pods and the container IDs of the processes from their cgroup containers.
+// The cache of the pods of the node is used first; the CRI runtime is the fallback for the pods that are not cached
+// yet, e.g., at the startup of the daemon or when a pod was just created. Either of them can be nil.
+type podResolver struct {
+	pods cache.Indexer
+	cri  *criClient
+}
+
+// resolvedPod is a pod and container resolved from a cgroup container.
+type resolvedPod struct {
+	name      string
+	namespace string
+	// containerID is the ID of the container, prefixed with the name of the runtime as in the pod status.
+	containerID string
+	// containerName is the name of the container, empty if the container is not in the status of the cached pod.
+	containerName string
+}
+
+// resolve returns the pod and container of the given cgroup container.
+// If the pod is not found, it returns nil without an error.
+// It returns an error if the CRI runtime fails to answer or if the runtime prefix of the container ID cannot be found.
+func (r *podResolver) resolve(ctx context.Context, container *cgroupContainer) (*resolvedPod, error) {
+	if pod := r.cachedPod(container.podUID); pod != nil {
+		containerID, err := r.containerIDFor(ctx, pod, container)
+		if err != nil {
+			return nil, err
+		}
+		return &resolvedPod{name: pod.Name, namespace: pod.Namespace, containerID: containerID,
+			containerName: containerNameFor(pod, container.containerID)}, nil
+	}
+	if r.cri == nil {
+		return nil, nil
+	}
+	name, namespace, err := r.cri.getPodNameFromUID(ctx, container.podUID)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, nil
+	}
+	containerID, err := r.cri.containerIDFor(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	return &resolvedPod{name: name, namespace: namespace, containerID: containerID}, nil
+}
+
+// cachedPod returns the pod with the given UID from the cache, or nil if it is not cached.
+func (r *podResolver) cachedPod(uid string) *corev1.Pod {
+	if r.pods == nil {
+		return nil
+	}
+	objs, err := r.pods.ByIndex(podUIDIndex, uid)
+	if err != nil || len(objs) == 0 {
+		return nil
+	}
+	pod, ok := objs[0].(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+	return pod
+}
+
+// containerIDFor returns the ID of the container of the cached pod, prefixed with the name of the runtime.
+// The runtime named in the cgroup path is used if any. Otherwise, the runtime prefix is taken from the container
+// statuses of the pod and, if the status of the container is not reported yet, from the CRI runtime.
+func (r *podResolver) containerIDFor(ctx context.Context, pod *corev1.Pod, container *cgroupContainer) (string, error) {
+	if container.runtime != "" {
+		return container.runtime + "://" + container.containerID, nil
+	}
+	if status := containerStatusFor(pod, container.containerID); status != nil {
+		return status.ContainerID, nil
+	}
+	if r.cri == nil {
+		return "", errors.New("the container is not in the pod status and no CRI runtime is available")
+	}
+	return r.cri.containerIDFor(ctx, container)
+}
+
+// containerStatusFor returns the status of the container of the pod with the given ID, without the runtime prefix, or
+// nil if the container is not in the pod status.
+func containerStatusFor(pod *corev1.Pod, containerID string) *corev1.ContainerStatus {
+	suffix := "://" + containerID
+	for _, statuses := range [][]corev1.ContainerStatus{
+		pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses, pod.Status.EphemeralContainerStatuses,
+	} {
+		for i := range statuses {
+			if strings.HasSuffix(statuses[i].ContainerID, suffix) {
+				return &statuses[i]
+			}
+		}
+	}
+	return nil
+}
+
+// containerNameFor returns the name of the container of the pod with the given ID, or an empty string if the
+// container is not in the pod status.
+func containerNameFor(pod *corev1.Pod, containerID string) string {
+	if status := containerStatusFor(pod, containerID); status != nil {
+		return status.Name
+	}
+	return ""
+}
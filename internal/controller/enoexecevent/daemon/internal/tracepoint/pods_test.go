@@ -0,0 +1,177 @@
+package tracepoint
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newCachedPod(name, uid string, containerIDs ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "cached-namespace", UID: types.UID(uid)},
+		Spec:       corev1.PodSpec{NodeName: "test-node"},
+	}
+	for _, containerID := range containerIDs {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses,
+			corev1.ContainerStatus{Name: "test-container", ContainerID: containerID})
+	}
+	return pod
+}
+
+func newPodIndexer(t *testing.T, pods ...*corev1.Pod) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{podUIDIndex: podUIDIndexFunc})
+	for _, pod := range pods {
+		if err := indexer.Add(pod); err != nil {
+			t.Fatalf("failed to add the pod %s to the indexer: %v", pod.Name, err)
+		}
+	}
+	return indexer
+}
+
+func TestPodResolver_resolve(t *testing.T) {
+	service := &fakeRuntimeService{runtimeName: "containerd"}
+	cri, err := newCRIClient(startFakeRuntimeService(t, service))
+	if err != nil {
+		t.Fatalf("newCRIClient() error = %v", err)
+	}
+	defer func() { _ = cri.close() }()
+	const cachedPodUID = "2b4ea5a2-8f8d-4a43-9c1f-3d2a5d1a3f7e"
+	tests := []struct {
+		name      string
+		pods      cache.Indexer
+		cri       *criClient
+		container *cgroupContainer
+		want      *resolvedPod
+		wantErr   bool
+	}{
+		{
+			name:      "cached pod with the runtime from the cgroup path",
+			pods:      newPodIndexer(t, newCachedPod("cached-pod", cachedPodUID)),
+			container: &cgroupContainer{podUID: cachedPodUID, containerID: testContainerID, runtime: "cri-o"},
+			want: &resolvedPod{name: "cached-pod", namespace: "cached-namespace",
+				containerID: "cri-o://" + testContainerID},
+		},
+		{
+			name:      "cached pod with the container in the status",
+			pods:      newPodIndexer(t, newCachedPod("cached-pod", cachedPodUID, "cri-o://"+testContainerID)),
+			container: &cgroupContainer{podUID: cachedPodUID, containerID: testContainerID, runtime: "cri-o"},
+			want: &resolvedPod{name: "cached-pod", namespace: "cached-namespace",
+				containerID: "cri-o://" + testContainerID, containerName: "test-container"},
+		},
+		{
+			name:      "cached pod with the runtime from the container status",
+			pods:      newPodIndexer(t, newCachedPod("cached-pod", cachedPodUID, "docker://other", "containerd://"+testContainerID)),
+			container: &cgroupContainer{podUID: cachedPodUID, containerID: testContainerID},
+			want: &resolvedPod{name: "cached-pod", namespace: "cached-namespace",
+				containerID: "containerd://" + testContainerID, containerName: "test-container"},
+		},
+		{
+			name:      "cached pod with the runtime from the CRI runtime",
+			pods:      newPodIndexer(t, newCachedPod("cached-pod", cachedPodUID)),
+			cri:       cri,
+			container: &cgroupContainer{podUID: cachedPodUID, containerID: testContainerID},
+			want: &resolvedPod{name: "cached-pod", namespace: "cached-namespace",
+				containerID: "containerd://" + testContainerID},
+		},
+		{
+			name: "mirror pod of a static pod",
+			pods: newPodIndexer(t, func() *corev1.Pod {
+				pod := newCachedPod("static-pod", "5f0e8a2c-6c7d-4b1e-9f3a-0d4c2b1a9e8f")
+				pod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "8c1b2f7b4c2d4e6a9f0e1d2c3b4a5f6e"}
+				return pod
+			}()),
+			container: &cgroupContainer{podUID: "8c1b2f7b4c2d4e6a9f0e1d2c3b4a5f6e", containerID: testContainerID, runtime: "cri-o"},
+			want: &resolvedPod{name: "static-pod", namespace: "cached-namespace",
+				containerID: "cri-o://" + testContainerID},
+		},
+		{
+			name:      "cached pod without a runtime",
+			pods:      newPodIndexer(t, newCachedPod("cached-pod", cachedPodUID)),
+			container: &cgroupContainer{podUID: cachedPodUID, containerID: testContainerID},
+			wantErr:   true,
+		},
+		{
+			name:      "pod not cached, CRI fallback",
+			pods:      newPodIndexer(t, newCachedPod("cached-pod", cachedPodUID)),
+			cri:       cri,
+			container: &cgroupContainer{podUID: testPodUID, containerID: testContainerID, runtime: "cri-o"},
+			want: &resolvedPod{name: "test-pod", namespace: "test-namespace",
+				containerID: "cri-o://" + testContainerID},
+		},
+		{
+			name:      "no pods cache, CRI only",
+			cri:       cri,
+			container: &cgroupContainer{podUID: testPodUID, containerID: testContainerID},
+			want: &resolvedPod{name: "test-pod", namespace: "test-namespace",
+				containerID: "containerd://" + testContainerID},
+		},
+		{
+			name:      "pod not cached and no CRI runtime",
+			pods:      newPodIndexer(t),
+			container: &cgroupContainer{podUID: testPodUID, containerID: testContainerID},
+		},
+		{
+			name:      "pod neither cached nor in the CRI runtime",
+			pods:      newPodIndexer(t),
+			cri:       cri,
+			container: &cgroupContainer{podUID: "not-found", containerID: testContainerID},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &podResolver{pods: tt.pods, cri: tt.cri}
+			got, err := r.resolve(context.Background(), tt.container)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolve() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewNodePodInformer(t *testing.T) {
+	localPod := newCachedPod("local-pod", testPodUID, "cri-o://"+testContainerID)
+	localPod.Spec.Containers = []corev1.Container{{Name: "test-container", Image: "quay.io/test/image:latest"}}
+	localPod.Labels = map[string]string{"app": "test"}
+	clientset := fake.NewSimpleClientset(localPod)
+
+	informer, err := NewNodePodInformer(clientset, "test-node", 0)
+	if err != nil {
+		t.Fatalf("NewNodePodInformer() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		t.Fatal("the pods cache did not sync")
+	}
+
+	// The fake clientset does not filter by field: check the field selector of the list request instead
+	list, ok := clientset.Actions()[0].(k8stesting.ListAction)
+	if !ok {
+		t.Fatalf("the first action is %v, want a list", clientset.Actions()[0])
+	}
+	if got := list.GetListRestrictions().Fields.String(); got != "spec.nodeName=test-node" {
+		t.Errorf("the field selector of the pods list is %q, want spec.nodeName=test-node", got)
+	}
+	r := &podResolver{pods: informer.GetIndexer()}
+	pod := r.cachedPod(testPodUID)
+	if pod == nil {
+		t.Fatal("the pod of the node is not cached")
+	}
+	want := newCachedPod("local-pod", testPodUID, "cri-o://"+testContainerID)
+	want.ResourceVersion = pod.ResourceVersion
+	if !reflect.DeepEqual(pod, want) {
+		t.Errorf("cached pod = %+v, want the trimmed pod %+v", pod, want)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/bits"
 	"os"
 	"unsafe"
 
@@ -15,13 +16,17 @@ import (
 
 	"github.com/go-logr/logr"
 
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/metrics"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/daemon/internal/types"
 	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
 )
 
 // Tracepoint represents an eBPF tracepoint that monitors the `execve` syscall
-// to detect ENOEXEC events. It captures the real parent and current task TGIDs
-// and retrieves the corresponding pod and container UUIDs from the CRI-O runtime.
+// to detect ENOEXEC events. It captures the real parent and current task TGIDs and the executed filename,
+// and retrieves the corresponding pod and container UUIDs from the cgroup of the process, the cache of the pods of the
+// node and the CRI runtime.
 type Tracepoint struct {
 	ctx context.Context
 
@@ -30,33 +35,57 @@ type Tracepoint struct {
 	progSpec *ebpf.ProgramSpec
 	link     link.Link
 
+	// The sys_enter_execve program stores the filename pointers in the filenames map for the sys_exit_execve one.
+	filenames     *ebpf.Map
+	enterProg     *ebpf.Program
+	enterProgSpec *ebpf.ProgramSpec
+	enterLink     link.Link
+
+	// The sys_exit_execve program counts the events dropped because the ring buffer is full in the dropped map.
+	// droppedEvents is the last value read from the map.
+	dropped       *ebpf.Map
+	droppedEvents uint64
+
 	tgidOffset       *int32
 	realParentOffset *int32
 	bufferSize       uint32 // Size of the ring buffer in bytes
 
 	ch chan *types.ENOEXECInternalEvent
 
+	pods *podResolver
+
+	// reportHostProcesses enables the node-scoped events for the tasks that do not run in a container.
+	reportHostProcesses bool
+
 	order binary.ByteOrder
 }
 
-func NewTracepoint(ctx context.Context, ch chan *types.ENOEXECInternalEvent, maxEvents uint32) (*Tracepoint, error) {
+// NewTracepoint creates a Tracepoint sending the events to the channel. The pods are resolved from the cache of the
+// pods of the node first, if not nil, and from the CRI runtime otherwise. The criSocket is the path of the unix socket
+// of the CRI runtime; the DefaultCRISockets are looked up if it is empty. A missing CRI runtime is not an error as long
+// as the pods cache is available. If reportHostProcesses is true, the ENOEXEC errors of the tasks that do not run in a
+// container are reported as node-scoped events.
+func NewTracepoint(ctx context.Context, ch chan *types.ENOEXECInternalEvent, maxEvents uint32, criSocket string,
+	pods cache.Indexer, reportHostProcesses bool) (*Tracepoint, error) {
 	// Buffer Size must be a multiple of page size
 	ps := os.Getpagesize()
 	if ps <= 0 || ps > 0xFFFFFFFF {
 		return nil, fmt.Errorf("invalid page size: %d", ps)
 	}
 	pageSize := uint32(ps) // [bytes]
-	// The payload is 8 bytes. Other 8 bytes are used for the header.
-	// 16 [bytes/event].
+	// The payload is 264 bytes. Other 8 bytes are used for the header.
+	// 272 [bytes/event].
 	payloadSize := PayloadSize + 8
 
-	// The buffer size has to be a multiple of the page size.
+	// The buffer size has to be a power of 2 multiple of the page size.
 	// We calculate the required buffer size based on the maximum number of events as
-	// size_max = maxEvents * 8 [bytes].
+	// size_max = maxEvents * 272 [bytes].
 	// We obtain the number of pages required to store the events rounding up the number of pages required
-	// to store size_max bytes: required_pages = Ceil(size_max [bytes] / pageSize [bytes]).
+	// to store size_max bytes: required_pages = Ceil(size_max [bytes] / pageSize [bytes]), rounded up to the next
+	// power of 2.
 	// Finally, we multiply required_pages by the page size to get the buffer size.
-	bufferSize := pageSize * uint32(math.Ceil(float64(maxEvents*payloadSize)/float64(pageSize)))
+	requiredPages := uint32(math.Ceil(float64(maxEvents*payloadSize) / float64(pageSize)))
+	bufferSize := pageSize * (uint32(1) << bits.Len32(requiredPages-1))
 
 	var i uint16 = 0x0001
 	b := (*[2]byte)(unsafe.Pointer(&i))
@@ -71,20 +100,42 @@ func NewTracepoint(ctx context.Context, ch chan *types.ENOEXECInternalEvent, max
 		fmt.Println("Detected little endian architecture")
 	}
 
+	log := logr.FromContextOrDiscard(ctx)
+	cri, err := newCRIClient(criSocket)
+	switch {
+	case err == nil:
+		log.Info("Using the CRI socket", "socket", cri.socket)
+	case pods != nil:
+		log.Info("No CRI runtime available, the pods are only resolved from the cache", "error", err)
+		cri = nil
+	default:
+		return nil, fmt.Errorf("failed to create the CRI client: %w", err)
+	}
+
 	tp := &Tracepoint{
+		pods:       &podResolver{pods: pods, cri: cri},
 		bufferSize: bufferSize,
 		ch:         ch,
 		ctx:        ctx,
 		order:      order,
+
+		reportHostProcesses: reportHostProcesses,
+
 		progSpec: &ebpf.ProgramSpec{
 			Name:     "multiarch_tuning_enoexec_tracepoint",
 			Type:     ebpf.TracePoint,
+			AttachTo: "syscalls:sys_exit_execve",
+			License:  "GPL",
+		},
+		enterProgSpec: &ebpf.ProgramSpec{
+			Name:     "multiarch_tuning_enoexec_filename_tracepoint",
+			Type:     ebpf.TracePoint,
 			AttachTo: "syscalls:sys_enter_execve",
 			License:  "GPL",
 		},
 	}
 	if err := tp.initializeOffsets(); err != nil {
-		return nil, fmt.Errorf("failed to initialize offsets: %w", err)
+		return nil, errors.Join(fmt.Errorf("failed to initialize offsets: %w", err), tp.close())
 	}
 	return tp, nil
 }
@@ -109,6 +160,36 @@ func (tp *Tracepoint) close() error {
 		}
 		tp.prog = nil
 	}
+	if tp.enterLink != nil {
+		if err := tp.enterLink.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close the sys_enter_execve tracepoint link: %w", err))
+		}
+		tp.enterLink = nil
+	}
+	if tp.enterProg != nil {
+		if err := tp.enterProg.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close the sys_enter_execve eBPF program: %w", err))
+		}
+		tp.enterProg = nil
+	}
+	if tp.filenames != nil {
+		if err := tp.filenames.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close filenames map: %w", err))
+		}
+		tp.filenames = nil
+	}
+	if tp.dropped != nil {
+		if err := tp.dropped.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close dropped events map: %w", err))
+		}
+		tp.dropped = nil
+	}
+	if tp.pods != nil && tp.pods.cri != nil {
+		if err := tp.pods.cri.close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close the CRI client: %w", err))
+		}
+		tp.pods.cri = nil
+	}
 	if tp.ctx != nil {
 		if cancelFunc, ok := tp.ctx.Value("cancelFunc").(context.CancelFunc); ok {
 			cancelFunc()
@@ -133,6 +214,43 @@ func (tp *Tracepoint) attach() error {
 		return errors.Join(fmt.Errorf("error creating the ring buffer"), err, tp.close())
 	}
 
+	tp.filenames, err = ebpf.NewMap(&ebpf.MapSpec{
+		Name: "multiarch_tuning_enoexec_filenames",
+		// The LRU hash map evicts the entries of the execve syscalls whose exit was not traced
+		Type:       ebpf.LRUHash,
+		KeySize:    8, // pid_tgid
+		ValueSize:  8, // filename pointer
+		MaxEntries: FilenamesMapMaxEntries,
+	})
+	if err != nil {
+		return errors.Join(fmt.Errorf("error creating the filenames map"), err, tp.close())
+	}
+
+	tp.dropped, err = ebpf.NewMap(&ebpf.MapSpec{
+		Name:       "multiarch_tuning_enoexec_dropped",
+		Type:       ebpf.Array,
+		KeySize:    4, // index 0
+		ValueSize:  8, // number of events dropped because the ring buffer is full
+		MaxEntries: 1,
+	})
+	if err != nil {
+		return errors.Join(fmt.Errorf("error creating the dropped events map"), err, tp.close())
+	}
+
+	if err = tp.initializeEnterProgSpec(); err != nil {
+		return errors.Join(fmt.Errorf("error initializing the sys_enter_execve eBPF program"), err, tp.close())
+	}
+
+	tp.enterProg, err = ebpf.NewProgram(tp.enterProgSpec)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to create the sys_enter_execve eBPF program"), err, tp.close())
+	}
+
+	tp.enterLink, err = link.Tracepoint("syscalls", "sys_enter_execve", tp.enterProg, nil)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to attach the sys_enter_execve tracepoint"), err, tp.close())
+	}
+
 	if err = tp.initializeProgSpec(); err != nil {
 		return errors.Join(fmt.Errorf("error initializing the eBPF program"), err, tp.close())
 	}
@@ -186,59 +304,145 @@ func (tp *Tracepoint) Run() error {
 			return nil
 		}
 		if err != nil {
+			metrics.RingBufferReads.WithLabelValues(metrics.ResultError).Inc()
 			log.Error(err, "failed to read from ring buffer")
 			return fmt.Errorf("failed to read from ring buffer: %w", err)
 		}
+		metrics.RingBufferReads.WithLabelValues(metrics.ResultSuccess).Inc()
+		metrics.ObservedEvents.Inc()
+		tp.syncDroppedEvents()
 		evt, err := tp.processRecord(&record)
 		if err != nil {
 			// Log the error and continue processing other records
+			metrics.UnresolvedEvents.Inc()
 			log.Info("Failed to process record", "error", err, "record_length", len(record.RawSample))
 			continue
 		}
+		if evt.PodName == "" {
+			metrics.ResolvedEvents.WithLabelValues(metrics.ScopeNode).Inc()
+		} else {
+			metrics.ResolvedEvents.WithLabelValues(metrics.ScopePod).Inc()
+		}
 		log.Info("ENOEXEC event detected", "event", evt)
-		tp.ch <- evt
+		select {
+		case tp.ch <- evt:
+		default:
+			log.Info("The queue of the events to store is full, dropping the event", "event", evt)
+			metrics.DroppedEvents.WithLabelValues(metrics.DroppedChannelFull).Inc()
+		}
+	}
+}
+
+// syncDroppedEvents adds the events dropped by the eBPF program since the last call to the dropped events metric.
+func (tp *Tracepoint) syncDroppedEvents() {
+	var dropped uint64
+	if err := tp.dropped.Lookup(uint32(0), &dropped); err != nil {
+		logr.FromContextOrDiscard(tp.ctx).V(5).Info("Failed to read the dropped events map", "error", err)
+		return
+	}
+	if dropped > tp.droppedEvents {
+		logr.FromContextOrDiscard(tp.ctx).Info("The ring buffer is full, events were dropped",
+			"dropped_events", dropped-tp.droppedEvents)
+		metrics.DroppedEvents.WithLabelValues(metrics.DroppedRingBufferFull).Add(float64(dropped - tp.droppedEvents))
+		tp.droppedEvents = dropped
 	}
 }
 
 func (tp *Tracepoint) processRecord(record *ringbuf.Record) (*types.ENOEXECInternalEvent, error) {
 	log := logr.FromContextOrDiscard(tp.ctx)
-	if len(record.RawSample) < 8 {
-		return nil, fmt.Errorf("record too short: %d bytes, expected at least 8 bytes", len(record.RawSample))
+	if len(record.RawSample) < int(PayloadSize) {
+		return nil, fmt.Errorf("record too short: %d bytes, expected at least %d bytes", len(record.RawSample), PayloadSize)
 	}
 	realParentTGID := tp.order.Uint32(record.RawSample[:4])
 	currentTaskTGID := tp.order.Uint32(record.RawSample[4:8])
+	executable := filenameFromRecord(record.RawSample[8:PayloadSize])
 	log.V(4).Info("Processing record",
-		"real_parent_tgid", realParentTGID, "current_task_tgid", currentTaskTGID)
+		"real_parent_tgid", realParentTGID, "current_task_tgid", currentTaskTGID, "executable", executable)
+	// hostPID and hostCgroup are the first task found outside the kubepods cgroups and its cgroup lines, reported in a
+	// node-scoped event if no pod is found and the reporting of the host processes is enabled.
+	var (
+		hostPID    uint32
+		hostCgroup []string
+	)
 	for _, pid := range []uint32{currentTaskTGID, realParentTGID} {
-		podUUID, containerUUID, err := getPodContainerUUIDFor(pid)
+		lines, err := readCgroup(pid)
 		if err != nil {
 			// Log the error and continue processing other pids as this is not a critical error
 			// (e.g., the pid might not exist anymore due to a delay in processing this record)
+			log.V(5).Info("Failed to read the cgroup of pid", "pid", pid, "error", err)
+			continue
+		}
+		container, err := parseCgroup(lines)
+		if err != nil {
+			// Log the error and continue processing other pids as this is not a critical error
+			// (e.g., the pid is not running in a container)
 			log.V(5).Info("Failed to get pod and container UUIDs for pid", "pid", pid, "error", err)
+			if hostCgroup == nil && isHostCgroup(lines) {
+				hostPID, hostCgroup = pid, lines
+			}
 			continue
 		}
-		podName, podNamespace, err := getPodNameFromUUID(tp.ctx, podUUID)
+		podUUID := container.podUID
+		pod, err := tp.pods.resolve(tp.ctx, container)
 		if err != nil {
-			// Errors from getPodNameFromUUID are critical if err is not nil, as it indicates a failure to connect to the CRI-O runtime
-			log.V(5).Info("Failed to get pod name from UUID", "pod_uuid", podUUID, "error", err)
-			return nil, fmt.Errorf("failed to get pod name from UUID %s: %w", podUUID, err)
+			// Errors from resolve are critical, as they indicate a failure to connect to the CRI runtime or to find
+			// the runtime prefix of the container ID
+			log.V(5).Info("Failed to resolve the pod from UUID", "pod_uuid", podUUID, "error", err)
+			return nil, fmt.Errorf("failed to resolve the pod from UUID %s: %w", podUUID, err)
 		}
-		if podName == "" {
-			// If podName is empty, it means the pod was not found in the CRI-O runtime, that is not a critical error
+		if pod == nil {
+			// If pod is nil, it means the pod was neither cached nor found in the CRI runtime, that is not a critical error
 			log.V(5).Info("Failed to get pod name from UUID", "pod_uuid", podUUID)
 			continue
 		}
+		podName, podNamespace, containerUUID := pod.name, pod.namespace, pod.containerID
+		// The executable is resolved in the filesystem of the task that called execve. It might have already exited:
+		// the task whose pod was found is tried as well, as it is usually in the same container.
+		executableArchitecture, err := readExecutableArchitecture(executable, currentTaskTGID, pid)
+		if err != nil {
+			log.V(5).Info("Failed to read the architecture of the executable", "executable", executable, "error", err)
+		}
 		log.Info("Found pod/container UUIDs in record", "pod_name", podName,
-			"pod_namespace", podNamespace, "container_id", containerUUID)
+			"pod_namespace", podNamespace, "container_id", containerUUID,
+			"executable", executable, "executable_architecture", executableArchitecture)
 		return &types.ENOEXECInternalEvent{
-			PodName:      podName,
-			PodNamespace: podNamespace,
-			ContainerID:  containerUUID,
+			PodName:                podName,
+			PodNamespace:           podNamespace,
+			ContainerID:            containerUUID,
+			ContainerName:          pod.containerName,
+			Executable:             executable,
+			ExecutableArchitecture: executableArchitecture,
 		}, nil
 	}
+	if tp.reportHostProcesses && hostCgroup != nil {
+		return tp.hostProcessEvent(hostPID, hostCgroup, executable, currentTaskTGID), nil
+	}
 	return nil, fmt.Errorf("failed to find pod/container UUIDs in record: hex:[% X] = (%d, %d)", record.RawSample, realParentTGID, currentTaskTGID) // No pod/container found
 }
 
+// hostProcessEvent returns the node-scoped event of a task that does not run in a container. The pod fields are empty
+// and the cgroup path and command line of the task are set instead.
+func (tp *Tracepoint) hostProcessEvent(pid uint32, cgroup []string, executable string, currentTaskTGID uint32) *types.ENOEXECInternalEvent {
+	log := logr.FromContextOrDiscard(tp.ctx)
+	command, err := readCommand(pid)
+	if err != nil {
+		log.V(5).Info("Failed to read the command line of the host process", "pid", pid, "error", err)
+	}
+	executableArchitecture, err := readExecutableArchitecture(executable, currentTaskTGID, pid)
+	if err != nil {
+		log.V(5).Info("Failed to read the architecture of the executable", "executable", executable, "error", err)
+	}
+	cgroupPath := cgroupPathOf(cgroup)
+	log.Info("Found a host process in record", "pid", pid, "cgroup_path", cgroupPath, "command", command,
+		"executable", executable, "executable_architecture", executableArchitecture)
+	return &types.ENOEXECInternalEvent{
+		CgroupPath:             cgroupPath,
+		Command:                command,
+		Executable:             executable,
+		ExecutableArchitecture: executableArchitecture,
+	}
+}
+
 func (tp *Tracepoint) monitor(rd *ringbuf.Reader) {
 	log := logr.FromContextOrDiscard(tp.ctx)
 	log.Info("Starting context monitoring goroutine for the tracepoint worker")
@@ -7,9 +7,16 @@ import (
 )
 
 const (
-	ExitLabel           = "exit"
-	CleanupLabel        = "cleanup"
-	PayloadSize  uint32 = 8 // [bytes]
+	ExitLabel                     = "exit"
+	CleanupLabel                  = "cleanup"
+	DeleteFilenameLabel           = "delete_filename"
+	DroppedLabel                  = "dropped"
+	FilenameSize           uint32 = 256              // [bytes]
+	PayloadSize            uint32 = 8 + FilenameSize // [bytes]
+	FilenamesMapMaxEntries        = 4096             // Max number of execve syscalls in progress
+	filenamePtrStackOffset        = -24              // Stack offset of the filename pointer in the exit program
+	pidTGIDStackOffset            = -16              // Stack offset of the pid_tgid key of the filenames map
+	droppedKeyStackOffset         = -32              // Stack offset of the key of the dropped events map
 )
 
 // https://stackoverflow.com/questions/9305992/if-threads-share-the-same-pid-how-can-they-be-identified
@@ -50,6 +57,19 @@ const (
 //                                V
 //print fmt: "0x%lx", REC->ret    V
 
+// The filename is not available in the sys_exit_execve tracepoint. It is an argument of the syscall, available in the
+// sys_enter_execve tracepoint:
+// └ # cat /sys/kernel/debug/tracing/events/syscalls/sys_enter_execve/format
+//  name: sys_enter_execve
+//  format:
+//        field:int __syscall_nr; 					offset:8;       size:4; signed:1;
+//        field:const char * filename; 				offset:16;      size:8; signed:0;
+//        field:const char *const * argv; 			offset:24;      size:8; signed:0;
+//        field:const char *const * envp; 			offset:32;      size:8; signed:0;
+// The enter program stores the user-space pointer to the filename in the filenames map, keyed by the pid_tgid of the
+// current task. The exit program looks it up, deletes it and, on ENOEXEC, copies the filename into the ring buffer.
+// The pointer is still valid when the syscall exits with ENOEXEC, as the address space of the task is unchanged.
+
 // https://www.kernel.org/doc/html/v5.17/bpf/instruction-set.html
 // R0: return value from function calls, and exit value for eBPF programs
 // R1 - R5: arguments for function calls
@@ -64,6 +84,12 @@ func (tp *Tracepoint) initializeProgSpec() error {
 	if tp.events.FD() == 0 {
 		return fmt.Errorf("events map FD is not set")
 	}
+	if tp.filenames.FD() == 0 {
+		return fmt.Errorf("filenames map FD is not set")
+	}
+	if tp.dropped.FD() == 0 {
+		return fmt.Errorf("dropped events map FD is not set")
+	}
 	if tp.tgidOffset == nil || tp.realParentOffset == nil {
 		return fmt.Errorf("tgidOffset or realParentOffset is not set")
 	}
@@ -74,6 +100,9 @@ func (tp *Tracepoint) initializeProgSpec() error {
 		// R6: current task's task_struct
 		// R7: ring buffer event pointer
 		// R8: real_parent task_struct
+		// R9: syscall return value
+		popFilenamePtr(tp.filenames.FD()),
+		// *** R9 = syscall return value, stack[filenamePtrStackOffset] = filename pointer or NULL
 		jExitIfNoENOEXEC(),
 		getCurrentTask(),
 		// *** R6 = current task_struct
@@ -91,10 +120,16 @@ func (tp *Tracepoint) initializeProgSpec() error {
 		// from R8 + tgidOffset into the ring buffer's first 4 bytes
 		loadIntoRingBufEvent(asm.R8, *tp.tgidOffset, 4, 0),
 
+		// Load the filename passed to execve into the ring buffer after the TGIDs
+		loadFilenameIntoRingBufEvent(8),
+
 		submitEvent(),
 		// Discard the reserved space in the ring buffer if submit failed.
 		// rollbackEvent() is skipped if submit succeeded with a jump to exit().
 		rollbackEvent(),
+		// Count the events dropped because the ring buffer is full.
+		// countDroppedEvent() is only reached by the jump of ringBufReserve() when the reservation fails.
+		countDroppedEvent(tp.dropped.FD()),
 		// Exit the eBPF program with a return value of 0.
 		exit(),
 	} {
@@ -103,13 +138,75 @@ func (tp *Tracepoint) initializeProgSpec() error {
 	return nil
 }
 
-// jExitIfNoENOEXEC checks if the syscall return value is ENOEXEC (-8).
+// initializeEnterProgSpec initializes the eBPF program for the sys_enter_execve tracepoint.
+// It must be called after the filenames map is created.
+// It must be called before the program is loaded.
+func (tp *Tracepoint) initializeEnterProgSpec() error {
+	if tp.filenames.FD() == 0 {
+		return fmt.Errorf("filenames map FD is not set")
+	}
+	tp.enterProgSpec.Instructions = asm.Instructions{
+		// Store the filename pointer (args->filename) on the stack
+		asm.LoadMem(asm.R6, asm.R1, 16, asm.DWord),
+		asm.StoreMem(asm.R10, -16, asm.R6, asm.DWord),
+		// Store the pid_tgid of the current task on the stack
+		// https://docs.ebpf.io/linux/helper-function/bpf_get_current_pid_tgid/
+		asm.FnGetCurrentPidTgid.Call(),
+		asm.StoreMem(asm.R10, -8, asm.R0, asm.DWord),
+		// https://docs.ebpf.io/linux/helper-function/bpf_map_update_elem/
+		// R1: pointer to the filenames map
+		// R2: pointer to the key (pid_tgid)
+		// R3: pointer to the value (filename pointer)
+		// R4: flags (BPF_ANY)
+		asm.LoadMapPtr(asm.R1, tp.filenames.FD()),
+		asm.Mov.Reg(asm.R2, asm.R10),
+		asm.Add.Imm(asm.R2, -8),
+		asm.Mov.Reg(asm.R3, asm.R10),
+		asm.Add.Imm(asm.R3, -16),
+		asm.Mov.Imm(asm.R4, 0),
+		asm.FnMapUpdateElem.Call(),
+		// The update can only fail if the map is full of stale entries: the LRU map evicts them instead.
+		asm.Mov.Imm(asm.R0, 0),
+		asm.Return(),
+	}
+	return nil
+}
+
+// popFilenamePtr stores the syscall return value in R9 and the filename pointer saved by the enter program
+// in the stack at filenamePtrStackOffset (NULL if not found). The entry is deleted from the filenames map
+// for every execve, whether it failed or not.
+// https://docs.ebpf.io/linux/helper-function/bpf_map_lookup_elem/
+// https://docs.ebpf.io/linux/helper-function/bpf_map_delete_elem/
+func popFilenamePtr(fd int) asm.Instructions {
+	return asm.Instructions{
+		asm.LoadMem(asm.R9, asm.R1, 16, asm.DWord),
+		asm.FnGetCurrentPidTgid.Call(),
+		asm.StoreMem(asm.R10, pidTGIDStackOffset, asm.R0, asm.DWord),
+		// StoreImm does not support double words: the NULL pointer is stored from a register
+		asm.Mov.Imm(asm.R1, 0),
+		asm.StoreMem(asm.R10, filenamePtrStackOffset, asm.R1, asm.DWord),
+		// R1: pointer to the filenames map
+		// R2: pointer to the key (pid_tgid)
+		asm.LoadMapPtr(asm.R1, fd),
+		asm.Mov.Reg(asm.R2, asm.R10),
+		asm.Add.Imm(asm.R2, pidTGIDStackOffset),
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, DeleteFilenameLabel),
+		asm.LoadMem(asm.R1, asm.R0, 0, asm.DWord),
+		asm.StoreMem(asm.R10, filenamePtrStackOffset, asm.R1, asm.DWord),
+		asm.LoadMapPtr(asm.R1, fd).WithSymbol(DeleteFilenameLabel),
+		asm.Mov.Reg(asm.R2, asm.R10),
+		asm.Add.Imm(asm.R2, pidTGIDStackOffset),
+		asm.FnMapDeleteElem.Call(),
+	}
+}
+
+// jExitIfNoENOEXEC checks if the syscall return value, stored in R9, is ENOEXEC (-8).
 // if it is not, it jumps to the exit label.
 // https://www.kernel.org/doc/man-pages/online/pages/man2/execve.2.html
 func jExitIfNoENOEXEC() asm.Instructions {
 	return asm.Instructions{
-		asm.LoadMem(asm.R0, asm.R1, 16, asm.DWord),
-		asm.JNE.Imm(asm.R0, -8, "exit"),
+		asm.JNE.Imm(asm.R9, -8, ExitLabel),
 	}
 }
 
@@ -125,22 +222,22 @@ func getCurrentTask() asm.Instructions {
 }
 
 // ringBufReserve reserves space in the ring buffer for the event.
-// It reserves space for the real_parent's TGID, current task's TGID.
+// It reserves space for the real_parent's TGID, current task's TGID and the filename.
 // https://docs.ebpf.io/linux/helper-function/bpf_ringbuf_reserve/
-// The reserved space is 16 bytes:
-// 2 * sizeof(int32) [bytes]
-// [ real_parent->tgid ][ current->tgid     ]
-// [------4 bytes------][------4 bytes------]
+// The reserved space is 264 bytes:
+// 2 * sizeof(int32) + FilenameSize [bytes]
+// [ real_parent->tgid ][ current->tgid     ][ filename (NUL-terminated) ]
+// [------4 bytes------][------4 bytes------][---------256 bytes---------]
 func ringBufReserve(fd int) asm.Instructions {
 	// R1: pointer to the ring buffer map
-	// R2: size of the event to reserve (24 bytes)
+	// R2: size of the event to reserve (264 bytes)
 	// R3: flags (must be 0)
 	return asm.Instructions{
 		asm.LoadMapPtr(asm.R1, fd),              // FD of ring buffer map
-		asm.Mov.Imm(asm.R2, int32(PayloadSize)), // Size of the event to reserve (8 bytes)
+		asm.Mov.Imm(asm.R2, int32(PayloadSize)), // Size of the event to reserve (264 bytes)
 		asm.Mov.Imm(asm.R3, 0),                  // Flags must be 0
 		asm.FnRingbufReserve.Call(),             // Reserve space in the ring buffer
-		asm.JEq.Imm(asm.R0, 0, ExitLabel),       // If reserve fails, exit
+		asm.JEq.Imm(asm.R0, 0, DroppedLabel),    // If reserve fails, count the dropped event and exit
 		asm.Mov.Reg(asm.R7, asm.R0),             // The address of the reserved space is stored in R7
 	}
 }
@@ -169,6 +266,24 @@ func loadIntoRingBufEvent(srcReg asm.Register, srcOffset, size, dstOffset int32)
 	}
 }
 
+// loadFilenameIntoRingBufEvent copies the NUL-terminated filename from the user-space pointer stored in the stack
+// into the ring buffer event at `dstOffset`. The filename is truncated to FilenameSize bytes.
+// A failure is not critical: the destination is zeroed by the helper on failure, and the event is still submitted
+// with an empty filename.
+// https://docs.ebpf.io/linux/helper-function/bpf_probe_read_user_str/
+func loadFilenameIntoRingBufEvent(dstOffset int32) asm.Instructions {
+	// R1: destination pointer (ring buffer)
+	// R2: size of the destination
+	// R3: source pointer (user-space filename)
+	return asm.Instructions{
+		asm.Mov.Reg(asm.R1, asm.R7),
+		asm.Add.Imm(asm.R1, dstOffset),
+		asm.Mov.Imm(asm.R2, int32(FilenameSize)),
+		asm.LoadMem(asm.R3, asm.R10, filenamePtrStackOffset, asm.DWord),
+		asm.FnProbeReadUserStr.Call(),
+	}
+}
+
 // loadRealParent loads the real_parent pointer from the current task's task_struct.
 // It reads the real_parent pointer from the task_struct and stores it in R8
 func loadRealParent(offset int32) asm.Instructions {
@@ -217,6 +332,25 @@ func rollbackEvent() asm.Instructions {
 		asm.Mov.Reg(asm.R1, asm.R7).WithSymbol(CleanupLabel),
 		asm.Mov.Imm(asm.R2, 0),
 		asm.FnRingbufDiscard.Call(),
+		asm.Ja.Label(ExitLabel), // jump past the dropped events counter
+	}
+}
+
+// countDroppedEvent atomically increments the counter of the events dropped because the ring buffer is full, stored at
+// the key 0 of the dropped events array map.
+// https://docs.ebpf.io/linux/helper-function/bpf_map_lookup_elem/
+func countDroppedEvent(fd int) asm.Instructions {
+	// R1: pointer to the dropped events map
+	// R2: pointer to the key (0)
+	return asm.Instructions{
+		asm.StoreImm(asm.R10, droppedKeyStackOffset, 0, asm.Word).WithSymbol(DroppedLabel),
+		asm.LoadMapPtr(asm.R1, fd),
+		asm.Mov.Reg(asm.R2, asm.R10),
+		asm.Add.Imm(asm.R2, droppedKeyStackOffset),
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, ExitLabel),
+		asm.Mov.Imm(asm.R1, 1),
+		asm.AddAtomic.Mem(asm.R0, asm.R1, asm.DWord, 0),
 	}
 }
 
@@ -15,6 +15,17 @@ type ENOEXECInternalEvent struct {
 	PodName      string `yaml:"podName,omitempty"`
 	PodNamespace string `yaml:"podNamespace,omitempty"`
 	ContainerID  string `yaml:"containerID,omitempty"`
+	// ContainerName is the name of the container, if known. It is used by the storages publishing the events directly,
+	// and to aggregate the errors of the restarts of a container, as the ENoExecEvent handler resolves it from the pod.
+	ContainerName string `yaml:"containerName,omitempty"`
+	// Executable is the filename passed to the execve syscall that failed with ENOEXEC.
+	Executable string `yaml:"executable,omitempty"`
+	// ExecutableArchitecture is the architecture read from the ELF header of the executable.
+	ExecutableArchitecture string `yaml:"executableArchitecture,omitempty"`
+	// CgroupPath is the cgroup path of the task, for the node-scoped events of the tasks not running in a pod.
+	CgroupPath string `yaml:"cgroupPath,omitempty"`
+	// Command is the command line of the task, for the node-scoped events of the tasks not running in a pod.
+	Command string `yaml:"command,omitempty"`
 }
 
 // ToENoExecEvent converts the ENOEXECInternalEvent to a multiarchv1beta1.ENOExecEvent that can be stored in Kubernetes.
@@ -29,6 +40,12 @@ func (e *ENOEXECInternalEvent) ToENoExecEvent(namespace string, nodeName string)
 			PodName:      e.PodName,
 			PodNamespace: e.PodNamespace,
 			ContainerID:  e.ContainerID,
+
+			Executable:             e.Executable,
+			ExecutableArchitecture: e.ExecutableArchitecture,
+
+			CgroupPath: e.CgroupPath,
+			Command:    e.Command,
 		},
 	}, nil
 }
@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -40,30 +41,45 @@ import (
 // Reconciler reconciles a ENoExecEvent object
 type Reconciler struct {
 	client.Client
+	// apiReader reads the objects outside the operator namespace, e.g., the ExecFormatErrorReports, that are not in
+	// the cache of the manager.
+	apiReader client.Reader
 	clientSet *kubernetes.Clientset
 	Scheme    *runtime.Scheme
 	recorder  record.EventRecorder
+	// retainENoExecEvents keeps the reconciled ENoExecEvents, with the outcome of the reconciliation in their status,
+	// instead of deleting them. They are garbage collected by the operator.
+	retainENoExecEvents bool
 }
 
-func NewReconciler(client client.Client, clientSet *kubernetes.Clientset, scheme *runtime.Scheme, recorder record.EventRecorder) *Reconciler {
+func NewReconciler(client client.Client, apiReader client.Reader, clientSet *kubernetes.Clientset, scheme *runtime.Scheme,
+	recorder record.EventRecorder, retainENoExecEvents bool) *Reconciler {
 	return &Reconciler{
-		Client:    client,
-		clientSet: clientSet,
-		Scheme:    scheme,
-		recorder:  recorder,
+		Client:              client,
+		apiReader:           apiReader,
+		clientSet:           clientSet,
+		Scheme:              scheme,
+		recorder:            recorder,
+		retainENoExecEvents: retainENoExecEvents,
 	}
 }
 
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=enoexecevents,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=enoexecevents/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=enoexecevents/finalizers,verbs=update
+//+kubebuilder:rbac:groups=multiarch.openshift.io,resources=execformaterrorreports,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=multiarch.openshift.io,resources=execformaterrorreports/status,verbs=get;update
 //+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;update
 //+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list
 
 // Reconcile will reconcile the ENoExecEvent resource.
 // It will fetch the ENoExecEvent instance, retrieve the pod and node information,
-// label the pod with the ENoExecEvent label, update the metrics, and publish an event.
+// label the pod with the ENoExecEvent label, update the metrics, publish an event, and summarize the error in the
+// ExecFormatErrorReport of the namespace of the pod.
+// The node-scoped ENoExecEvents, raised by the processes not running in a pod, are published as events of the node.
 // Finally, it will delete the ENoExecEvent resource if the reconciliation was successful or if the pod was not found.
+// When the ENoExecEvents are retained, the outcome of the reconciliation is recorded in the status of the ENoExecEvent
+// instead, and the ENoExecEvents already reconciled are skipped.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	metrics.InitMetrics()
@@ -74,6 +90,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if r.retainENoExecEvents && enoExecEventObj.Status.IsHandled() {
+		logger.V(5).Info("ENoExecEvent already reconciled, skipping reconciliation", "name", enoExecEventObj.Name,
+			"outcome", enoExecEventObj.Status.Outcome)
+		return ctrl.Result{}, nil
+	}
+
 	eNoExecEvent := NewENoExecEvent(enoExecEventObj, ctx, r.recorder)
 
 	if eNoExecEvent.Namespace != utils.Namespace() {
@@ -83,7 +105,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
-	if eNoExecEvent.Status.PodName == "" {
+	nodeScoped := eNoExecEvent.Status.IsNodeScoped()
+	if !nodeScoped && eNoExecEvent.Status.PodName == "" {
 		// If the ENoExecEvent does not have a pod name, we ignore it
 		logger.V(5).Info("ENoExecEvent does not have a pod name, skipping reconciliation", "name", eNoExecEvent.Name, "namespace", eNoExecEvent.Namespace)
 		r.markAsError(ctx, eNoExecEvent, ErrorReasonPodNotFound)
@@ -92,9 +115,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Log the ENoExecEvent instance
-	logger.Info("Reconciling ENoExecEvent", "name", eNoExecEvent.Name, "namespace", eNoExecEvent.Namespace)
-	ret, err := r.reconcile(ctx, eNoExecEvent)
+	logger.Info("Reconciling ENoExecEvent", "name", eNoExecEvent.Name, "namespace", eNoExecEvent.Namespace,
+		"nodeScoped", nodeScoped)
+	reconcileFn := r.reconcile
+	if nodeScoped {
+		reconcileFn = r.reconcileNodeScoped
+	}
+	ret, err := reconcileFn(ctx, eNoExecEvent)
 	// If the reconciliation was successful, or one of the objects was not found, we delete the ENoExecEvent resource.
+	if client.IgnoreNotFound(err) == nil && r.retainENoExecEvents {
+		if err := r.recordOutcome(ctx, eNoExecEvent); err != nil {
+			logger.Error(err, "Failed to record the outcome of the reconciliation in the ENoExecEvent", "name", eNoExecEvent.Name)
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		metrics.EnoexecCounter.Inc()
+		logger.Info("Recorded the outcome of the reconciliation in the ENoExecEvent", "name", eNoExecEvent.Name,
+			"outcome", eNoExecEvent.Status.Outcome, "reason", eNoExecEvent.Status.Reason)
+		return ret, nil
+	}
 	if client.IgnoreNotFound(err) == nil {
 		if err := r.Delete(ctx, &eNoExecEvent.ENoExecEvent); err != nil {
 			logger.Error(err, "Failed to delete ENoExecEvent resource after reconciliation", "name", eNoExecEvent.Name)
@@ -156,10 +194,13 @@ func (r *Reconciler) reconcile(ctx context.Context, eNoExecEvent *ENoExecEvent)
 		logger.Error(err, "Container ID not found in pod status", "containerID", eNoExecEvent.Status.ContainerID)
 		containerName = utils.UnknownContainer
 	}
+	eNoExecEvent.Status.ContainerName = containerName
+	eNoExecEvent.Status.NodeArchitecture = node.Labels[utils.ArchLabel]
 
 	logger.Info("Publishing event for ENoExecEvent", "podName", pod.Name, "namespace", pod.Namespace)
 	pod.PublishEvent(v1.EventTypeWarning, utils.ExecFormatErrorEventReason,
-		utils.ExecFormatErrorEventMessage(containerName, node.Labels[utils.ArchLabel]))
+		utils.ExecFormatErrorEventMessage(containerName, node.Labels[utils.ArchLabel],
+			eNoExecEvent.Status.Executable, eNoExecEvent.Status.ExecutableArchitecture))
 
 	// Label the pod with the ENoExecEvent label.
 	pod.EnsureLabel(utils.ExecFormatErrorLabelKey, utils.True)
@@ -172,6 +213,35 @@ func (r *Reconciler) reconcile(ctx context.Context, eNoExecEvent *ENoExecEvent)
 		r.markAsError(ctx, eNoExecEvent, ErrorReasonPodNotFound)
 		return ctrl.Result{}, err
 	}
+
+	if err = r.updateReport(ctx, pod.Namespace, newExecFormatError(pod, eNoExecEvent)); err != nil {
+		logger.Error(err, "Failed to update the ExecFormatErrorReport", "namespace", pod.Namespace)
+		// Mark as error but return the original error so client.IgnoreNotFound works
+		r.markAsError(ctx, eNoExecEvent, ErrorReasonReconciliation)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileNodeScoped publishes the node-scoped ENoExecEvent as an event of its node.
+func (r *Reconciler) reconcileNodeScoped(ctx context.Context, eNoExecEvent *ENoExecEvent) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	node, err := r.clientSet.CoreV1().Nodes().Get(ctx, eNoExecEvent.Status.NodeName, metav1.GetOptions{})
+	if err != nil {
+		// If the node is not found, the error will be ignored and the ENoExecEvent will be deleted by the caller.
+		logger.Error(err, "Failed to get node for the node-scoped ENoExecEvent", "nodeName", eNoExecEvent.Status.NodeName)
+		// Mark as error but return the original error so client.IgnoreNotFound works
+		r.markAsError(ctx, eNoExecEvent, ErrorReasonNodeNotFound)
+		return ctrl.Result{}, err
+	}
+
+	eNoExecEvent.Status.NodeArchitecture = node.Labels[utils.ArchLabel]
+	logger.Info("Publishing node event for ENoExecEvent", "nodeName", node.Name,
+		"cgroupPath", eNoExecEvent.Status.CgroupPath, "command", eNoExecEvent.Status.Command)
+	r.recorder.Event(node, v1.EventTypeWarning, utils.ExecFormatErrorEventReason,
+		utils.HostExecFormatErrorEventMessage(eNoExecEvent.Status.Command, eNoExecEvent.Status.CgroupPath,
+			node.Labels[utils.ArchLabel], eNoExecEvent.Status.Executable, eNoExecEvent.Status.ExecutableArchitecture))
+	metrics.EnoexecHostCounter.WithLabelValues(node.Name).Inc()
 	return ctrl.Result{}, nil
 }
 
@@ -191,6 +261,31 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
+// recordOutcome records the outcome of the reconciliation in the status of the retained ENoExecEvent.
+// A conflict with an update of the ENoExecEvent daemon, aggregating new exec format errors, only retries the status
+// write on the re-fetched ENoExecEvent, so that the reconciliation, publishing the events and counting the error, is
+// not run again.
+func (r *Reconciler) recordOutcome(ctx context.Context, eNoExecEvent *ENoExecEvent) error {
+	eNoExecEvent.SetOutcome(metav1.Now())
+	handled := eNoExecEvent.Status.DeepCopy()
+	firstAttempt := true
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !firstAttempt {
+			if err := r.Get(ctx, client.ObjectKeyFromObject(&eNoExecEvent.ENoExecEvent), &eNoExecEvent.ENoExecEvent); err != nil {
+				return err
+			}
+			// Keep the fields written by the daemon and set the ones resolved by the reconciliation.
+			eNoExecEvent.Status.ContainerName = handled.ContainerName
+			eNoExecEvent.Status.NodeArchitecture = handled.NodeArchitecture
+			eNoExecEvent.Status.Outcome = handled.Outcome
+			eNoExecEvent.Status.Reason = handled.Reason
+			eNoExecEvent.Status.HandledAt = handled.HandledAt
+		}
+		firstAttempt = false
+		return r.Status().Update(ctx, &eNoExecEvent.ENoExecEvent)
+	})
+}
+
 // markAsError marks the ENoExecEvent with an error label and persists it.
 // The errored events are kept in the cluster and cleaned up later by the operator controller's
 // deleteErroredENoExecEvents function during CPPC deletion or plugin disable.
@@ -199,6 +294,7 @@ func (r *Reconciler) markAsError(ctx context.Context, eNoExecEvent *ENoExecEvent
 
 	// Set the error label
 	eNoExecEvent.EnsureLabel(ENoExecEventErrorLabel, errorReason)
+	eNoExecEvent.failureReason = errorReason
 
 	// Update the ENoExecEvent to persist the label.
 	// Ignore NotFound errors - the object may have already been deleted.
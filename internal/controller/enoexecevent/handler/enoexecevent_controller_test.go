@@ -53,6 +53,25 @@ func ensureEvent(podName string, message string) AsyncAssertion {
 	}).WithPolling(e2e.PollingInterval).WithTimeout(e2e.WaitShort)
 }
 
+func ensureNodeEvent(nodeName string, message string) AsyncAssertion {
+	return Eventually(func(g Gomega) {
+		// The events of the cluster-scoped objects are published in the default namespace
+		events, err := framework.GetEventsForObject(ctx, k8sClientSet, nodeName, "default")
+		g.Expect(err).NotTo(HaveOccurred(), "failed to get events for Node", err)
+		found := false
+		for _, event := range events {
+			if event.Reason == utils.ExecFormatErrorEventReason &&
+				event.Message == message &&
+				event.InvolvedObject.Kind == "Node" &&
+				event.InvolvedObject.Name == nodeName {
+				found = true
+				break
+			}
+		}
+		g.Expect(found).To(BeTrue(), "Event not found in Node events")
+	}).WithPolling(e2e.PollingInterval).WithTimeout(e2e.WaitShort)
+}
+
 func ensureDeletion(eneeName string) {
 	Eventually(func(g Gomega) {
 		enee := &v1beta1.ENoExecEvent{}
@@ -193,13 +212,57 @@ var _ = Describe("internal/Controller/ENoExecEvent/Reconciler", func() {
 				enee := defaultENoExecFormatError().WithPodName(podName).WithName(eneeName).Build()
 				createENEEAndUpdateStatus(enee)
 				By("Ensuring the event is published")
-				ensureEvent(podName, utils.ExecFormatErrorEventMessage(testContainerName, testNodeArch)).
+				ensureEvent(podName, utils.ExecFormatErrorEventMessage(testContainerName, testNodeArch, "", "")).
+					Should(Succeed(), "failed to get event for Pod")
+				By("Ensuring the ENoExecEvent is deleted")
+				ensureDeletion(eneeName)
+				By("Deleting pod")
+				deletePod(podName)
+			})
+			It("should publish an event naming the executable and its architecture to the pod", func() {
+				// Create the pod
+				podName := framework.GenerateName()
+				eneeName := framework.GenerateName()
+				pod := builder.NewPod().WithNamespace(testNamespace).WithName(podName).WithNodeName(testNodeName).
+					WithContainer("test-image", v1.PullAlways).
+					WithContainerStatuses(builder.NewContainerStatus().WithName(testContainerName).WithID(testContainerID).Build()).
+					Build()
+				createPodAndUpdateStatus(pod)
+				// Create the ENoExecEvent object
+				enee := defaultENoExecFormatError().WithPodName(podName).WithName(eneeName).
+					WithExecutable("/usr/bin/app", utils.ArchitectureAmd64).Build()
+				createENEEAndUpdateStatus(enee)
+				By("Ensuring the event is published")
+				ensureEvent(podName, utils.ExecFormatErrorEventMessage(testContainerName, testNodeArch,
+					"/usr/bin/app", utils.ArchitectureAmd64)).
 					Should(Succeed(), "failed to get event for Pod")
 				By("Ensuring the ENoExecEvent is deleted")
 				ensureDeletion(eneeName)
 				By("Deleting pod")
 				deletePod(podName)
 			})
+			It("should publish an event to the node for a host process", func() {
+				eneeName := framework.GenerateName()
+				enee := builder.NewENoExecEvent().WithName(eneeName).WithNamespace(utils.Namespace()).
+					WithNodeName(testNodeName).WithHostProcess("/system.slice/my-agent.service", "/usr/bin/my-agent --serve").
+					WithExecutable("/usr/libexec/my-agent-helper", utils.ArchitectureAmd64).Build()
+				createENEEAndUpdateStatus(enee)
+				By("Ensuring the event is published to the node")
+				ensureNodeEvent(testNodeName, utils.HostExecFormatErrorEventMessage("/usr/bin/my-agent --serve",
+					"/system.slice/my-agent.service", testNodeArch, "/usr/libexec/my-agent-helper", utils.ArchitectureAmd64)).
+					Should(Succeed(), "failed to get event for Node")
+				By("Ensuring the ENoExecEvent is deleted")
+				ensureDeletion(eneeName)
+			})
+			It("should delete a host process ENoExecEvent whose node is not found", func() {
+				eneeName := framework.GenerateName()
+				enee := builder.NewENoExecEvent().WithName(eneeName).WithNamespace(utils.Namespace()).
+					WithNodeName("not-found-node").WithHostProcess("/system.slice/my-agent.service", "/usr/bin/my-agent").
+					Build()
+				createENEEAndUpdateStatus(enee)
+				By("Ensuring the ENoExecEvent is deleted")
+				ensureDeletion(eneeName)
+			})
 			It("should label the pod with the ENoExecEvent label", func() {
 				// Create the pod
 				podName := framework.GenerateName()
@@ -218,6 +281,36 @@ var _ = Describe("internal/Controller/ENoExecEvent/Reconciler", func() {
 				By("Deleting pod")
 				deletePod(podName)
 			})
+			It("should summarize the exec format error in the ExecFormatErrorReport of the pod namespace", func() {
+				podName := framework.GenerateName()
+				eneeName := framework.GenerateName()
+				pod := builder.NewPod().WithNamespace(testNamespace).WithName(podName).WithNodeName(testNodeName).
+					WithContainer("test-image", v1.PullAlways).
+					WithContainerStatuses(builder.NewContainerStatus().WithName(testContainerName).WithID(testContainerID).Build()).
+					Build()
+				createPodAndUpdateStatus(pod)
+				enee := defaultENoExecFormatError().WithPodName(podName).WithName(eneeName).Build()
+				createENEEAndUpdateStatus(enee)
+				By("Ensuring the ExecFormatErrorReport lists the pod")
+				Eventually(func(g Gomega) {
+					report := &v1beta1.ExecFormatErrorReport{}
+					g.Expect(k8sClient.Get(ctx, crclient.ObjectKey{
+						Name:      v1beta1.ExecFormatErrorReportName,
+						Namespace: testNamespace,
+					}, report)).To(Succeed())
+					g.Expect(report.Status.Count).To(BeNumerically(">=", 1))
+					g.Expect(report.Status.Workloads).To(ContainElement(And(
+						HaveField("Kind", "Pod"),
+						HaveField("Name", podName),
+						HaveField("Count", int32(1)),
+						HaveField("Containers", ContainElement(HaveField("ContainerID", testContainerID))),
+					)))
+				}).Should(Succeed(), "the ExecFormatErrorReport should list the pod")
+				By("Ensuring the ENoExecEvent is deleted")
+				ensureDeletion(eneeName)
+				By("Deleting pod")
+				deletePod(podName)
+			})
 			It("should delete the ENoExecEvent object if the pod is not found", func() {
 				// Create the ENoExecEvent object
 				eneeName := framework.GenerateName()
@@ -244,7 +337,7 @@ var _ = Describe("internal/Controller/ENoExecEvent/Reconciler", func() {
 				By("Ensuring the pod is not labeled with ENoExecEvent label")
 				ensureLabel(podName).ShouldNot(Succeed(), "the pod should not have the ENoExecEvent label if the node is not found")
 				By("Ensuring the pod does not have an event published")
-				ensureEvent(podName, utils.ExecFormatErrorEventMessage(testContainerName, testNodeArch)).
+				ensureEvent(podName, utils.ExecFormatErrorEventMessage(testContainerName, testNodeArch, "", "")).
 					ShouldNot(Succeed(), "the pod should not have an event published if the node is not found")
 				By("Deleting pod")
 				deletePod(podName)
@@ -264,7 +357,7 @@ var _ = Describe("internal/Controller/ENoExecEvent/Reconciler", func() {
 				// Ensure the ENoExecEvent is deleted
 				ensureDeletion(eneeName)
 				// Ensure the event is published
-				ensureEvent(podName, utils.ExecFormatErrorEventMessage(utils.UnknownContainer, testNodeArch)).
+				ensureEvent(podName, utils.ExecFormatErrorEventMessage(utils.UnknownContainer, testNodeArch, "", "")).
 					Should(Succeed(), "failed to get event for Pod with wrong container ID")
 				ensureLabel(podName).Should(Succeed(), "failed to label Pod with ENoExecEvent label for wrong container ID")
 
@@ -285,7 +378,7 @@ var _ = Describe("internal/Controller/ENoExecEvent/Reconciler", func() {
 				By("Ensuring the pod is not labeled with ENoExecEvent label")
 				ensureLabel(podName).ShouldNot(Succeed(), "the pod should not have the ENoExecEvent label if the node is not found")
 				By("Ensuring the pod does not have an event published")
-				ensureEvent(podName, utils.ExecFormatErrorEventMessage(testContainerName, testNodeArch)).
+				ensureEvent(podName, utils.ExecFormatErrorEventMessage(testContainerName, testNodeArch, "", "")).
 					ShouldNot(Succeed(), "the pod should not have an event published if the node is not found")
 				By("Deleting pod")
 				deletePod(podName)
@@ -587,7 +680,7 @@ var _ = Describe("internal/Controller/ENoExecEvent/Reconciler", func() {
 
 				// Should still publish event with "unknown-container"
 				By("Ensuring the event is published with unknown container")
-				ensureEvent(podName, utils.ExecFormatErrorEventMessage(utils.UnknownContainer, testNodeArch)).
+				ensureEvent(podName, utils.ExecFormatErrorEventMessage(utils.UnknownContainer, testNodeArch, "", "")).
 					Should(Succeed(), "failed to get event for Pod with mismatched container ID")
 
 				By("Ensuring the pod is labeled with exec format error label even with unknown container")
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
 	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
@@ -31,6 +32,8 @@ type ENoExecEvent struct {
 	multiarchv1beta1.ENoExecEvent
 	ctx      context.Context
 	recorder record.EventRecorder
+	// failureReason is the reason of the last error of the current reconciliation, if any.
+	failureReason string
 }
 
 // NewENoExecEvent creates a new ENoExecEvent wrapper
@@ -97,6 +100,17 @@ func (e *ENoExecEvent) HasErrorLabel() bool {
 	return exists
 }
 
+// SetOutcome sets the outcome of the reconciliation in the status of the ENoExecEvent: Failed, with the reason of the
+// last error of the reconciliation, or Published.
+func (e *ENoExecEvent) SetOutcome(handledAt metav1.Time) {
+	e.Status.Outcome = multiarchv1beta1.ENoExecEventOutcomePublished
+	if e.failureReason != "" {
+		e.Status.Outcome = multiarchv1beta1.ENoExecEventOutcomeFailed
+	}
+	e.Status.Reason = e.failureReason
+	e.Status.HandledAt = &handledAt
+}
+
 // PublishEvent publishes a Kubernetes event for the ENoExecEvent CR
 func (e *ENoExecEvent) PublishEvent(eventType, reason, message string) {
 	if e.recorder != nil {
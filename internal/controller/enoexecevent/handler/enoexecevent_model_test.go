@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
+)
+
+func TestENoExecEvent_SetOutcome(t *testing.T) {
+	tests := []struct {
+		name          string
+		failureReason string
+		wantOutcome   v1beta1.ENoExecEventOutcome
+	}{
+		{
+			name:        "successful reconciliation",
+			wantOutcome: v1beta1.ENoExecEventOutcomePublished,
+		},
+		{
+			name:          "failed reconciliation",
+			failureReason: ErrorReasonPodNotFound,
+			wantOutcome:   v1beta1.ENoExecEventOutcomeFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			e := NewENoExecEvent(builder.NewENoExecEvent().WithName("test").Build(), context.Background(), nil)
+			e.failureReason = tt.failureReason
+			handledAt := metav1.NewTime(time.Now())
+			e.SetOutcome(handledAt)
+			g.Expect(e.Status.Outcome).To(Equal(tt.wantOutcome))
+			g.Expect(e.Status.Reason).To(Equal(tt.failureReason))
+			g.Expect(e.Status.IsHandled()).To(BeTrue())
+			g.Expect(e.Status.HandledAt.Time).To(Equal(handledAt.Time))
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/models"
+)
+
+// execFormatError is the exec format error of a pod container reported by an ENoExecEvent, as summarized in the
+// ExecFormatErrorReport of the namespace of the pod.
+type execFormatError struct {
+	workloadKind     string
+	workloadName     string
+	containerName    string
+	containerID      string
+	nodeArchitecture string
+	// count is the number of errors aggregated by the ENoExecEvent daemon for the container since firstSeen.
+	count     int32
+	firstSeen metav1.Time
+	lastSeen  metav1.Time
+}
+
+// newExecFormatError returns the exec format error reported by the ENoExecEvent for the given pod. The ENoExecEvents
+// not aggregated by the daemon count as a single error seen at the time of their reconciliation.
+func newExecFormatError(pod *models.Pod, eNoExecEvent *ENoExecEvent) *execFormatError {
+	workloadKind, workloadName := pod.Workload()
+	e := &execFormatError{
+		workloadKind:     workloadKind,
+		workloadName:     workloadName,
+		containerName:    eNoExecEvent.Status.ContainerName,
+		containerID:      eNoExecEvent.Status.ContainerID,
+		nodeArchitecture: eNoExecEvent.Status.NodeArchitecture,
+		count:            max(eNoExecEvent.Status.Count, 1),
+		lastSeen:         metav1.Now(),
+	}
+	if eNoExecEvent.Status.LastSeen != nil {
+		e.lastSeen = *eNoExecEvent.Status.LastSeen
+	}
+	e.firstSeen = e.lastSeen
+	if eNoExecEvent.Status.FirstSeen != nil {
+		e.firstSeen = *eNoExecEvent.Status.FirstSeen
+	}
+	return e
+}
+
+// updateReport records the exec format error in the ExecFormatErrorReport of the given namespace, creating it if it
+// does not exist yet.
+func (r *Reconciler) updateReport(ctx context.Context, namespace string, e *execFormatError) error {
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		// The report may be updated, or created, by a concurrent reconciliation.
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		report := &multiarchv1beta1.ExecFormatErrorReport{}
+		// The cache of the manager only holds the objects of the operator namespace.
+		err := r.apiReader.Get(ctx, client.ObjectKey{Name: multiarchv1beta1.ExecFormatErrorReportName, Namespace: namespace}, report)
+		if apierrors.IsNotFound(err) {
+			report = &multiarchv1beta1.ExecFormatErrorReport{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      multiarchv1beta1.ExecFormatErrorReportName,
+					Namespace: namespace,
+				},
+			}
+			if err := r.Create(ctx, report); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+		if !recordExecFormatError(&report.Status, e) {
+			return nil
+		}
+		return r.Status().Update(ctx, report)
+	})
+}
+
+// recordExecFormatError summarizes the exec format error in the status of the ExecFormatErrorReport. It returns false
+// if the status did not change.
+// The ENoExecEvent daemon reports the errors of a container again, with an increased count, when it aggregates new
+// ones: the errors are identified by the container ID and the time the first of them was seen, and only the increase
+// of their count is added to the counts of the workload and of the namespace.
+func recordExecFormatError(status *multiarchv1beta1.ExecFormatErrorReportStatus, e *execFormatError) bool {
+	var workload *multiarchv1beta1.ExecFormatErrorWorkload
+	for i := range status.Workloads {
+		if status.Workloads[i].Kind == e.workloadKind && status.Workloads[i].Name == e.workloadName {
+			workload = &status.Workloads[i]
+			break
+		}
+	}
+	if workload == nil {
+		status.Workloads = append(status.Workloads, multiarchv1beta1.ExecFormatErrorWorkload{
+			Kind: e.workloadKind,
+			Name: e.workloadName,
+		})
+		workload = &status.Workloads[len(status.Workloads)-1]
+	}
+	var container *multiarchv1beta1.ExecFormatErrorContainer
+	for i := range workload.Containers {
+		if workload.Containers[i].ContainerID == e.containerID && workload.Containers[i].FirstSeen.Equal(&e.firstSeen) {
+			container = &workload.Containers[i]
+			break
+		}
+	}
+	if container == nil {
+		workload.Containers = append(workload.Containers, multiarchv1beta1.ExecFormatErrorContainer{
+			Name:        e.containerName,
+			ContainerID: e.containerID,
+			FirstSeen:   e.firstSeen.DeepCopy(),
+		})
+		container = &workload.Containers[len(workload.Containers)-1]
+	}
+	if e.count <= container.Count {
+		return false
+	}
+	increase := e.count - container.Count
+	container.Count = e.count
+	container.LastSeen = latest(container.LastSeen, e.lastSeen)
+	workload.Count += increase
+	workload.LastSeen = latest(workload.LastSeen, e.lastSeen)
+	if e.nodeArchitecture != "" && !slices.Contains(workload.NodeArchitectures, e.nodeArchitecture) {
+		workload.NodeArchitectures = append(workload.NodeArchitectures, e.nodeArchitecture)
+		sort.Strings(workload.NodeArchitectures)
+	}
+	status.Count += increase
+	status.LastSeen = latest(status.LastSeen, e.lastSeen)
+
+	// The containers and workloads are listed the most recent first, and the least recent are dropped when the lists
+	// are full. Their errors are still counted in the totals.
+	sortByLastSeen(workload.Containers, func(c multiarchv1beta1.ExecFormatErrorContainer) *metav1.Time { return c.LastSeen })
+	if len(workload.Containers) > multiarchv1beta1.MaxReportedExecFormatErrorContainers {
+		workload.Containers = workload.Containers[:multiarchv1beta1.MaxReportedExecFormatErrorContainers]
+	}
+	sortByLastSeen(status.Workloads, func(w multiarchv1beta1.ExecFormatErrorWorkload) *metav1.Time { return w.LastSeen })
+	if len(status.Workloads) > multiarchv1beta1.MaxReportedExecFormatErrorWorkloads {
+		status.Workloads = status.Workloads[:multiarchv1beta1.MaxReportedExecFormatErrorWorkloads]
+	}
+	return true
+}
+
+// latest returns the latest of the given times.
+func latest(t *metav1.Time, u metav1.Time) *metav1.Time {
+	if t != nil && !t.Before(&u) {
+		return t
+	}
+	return u.DeepCopy()
+}
+
+// sortByLastSeen sorts the items by their last seen time, the most recent first.
+func sortByLastSeen[T any](items []T, lastSeen func(T) *metav1.Time) {
+	sort.SliceStable(items, func(i, j int) bool {
+		ti, tj := lastSeen(items[i]), lastSeen(items[j])
+		return ti != nil && (tj == nil || tj.Before(ti))
+	})
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+func TestRecordExecFormatError(t *testing.T) {
+	t0 := metav1.NewTime(time.Now().Truncate(time.Second))
+	t1 := metav1.NewTime(t0.Add(time.Minute))
+	t2 := metav1.NewTime(t0.Add(2 * time.Minute))
+	newError := func(workloadName, containerID, nodeArchitecture string, count int32, firstSeen, lastSeen metav1.Time) *execFormatError {
+		return &execFormatError{
+			workloadKind:     "Deployment",
+			workloadName:     workloadName,
+			containerName:    "app",
+			containerID:      containerID,
+			nodeArchitecture: nodeArchitecture,
+			count:            count,
+			firstSeen:        firstSeen,
+			lastSeen:         lastSeen,
+		}
+	}
+	tests := []struct {
+		name              string
+		errors            []*execFormatError
+		wantChanged       bool
+		wantCount         int32
+		wantWorkloads     int
+		wantWorkloadCount int32
+		wantContainers    int
+		wantArchitectures []string
+	}{
+		{
+			name:              "new workload",
+			errors:            []*execFormatError{newError("web", "cri-o://a", utils.ArchitectureArm64, 1, t0, t0)},
+			wantChanged:       true,
+			wantCount:         1,
+			wantWorkloads:     1,
+			wantWorkloadCount: 1,
+			wantContainers:    1,
+			wantArchitectures: []string{utils.ArchitectureArm64},
+		},
+		{
+			name: "same errors reported again with an increased count",
+			errors: []*execFormatError{
+				newError("web", "cri-o://a", utils.ArchitectureArm64, 2, t0, t0),
+				newError("web", "cri-o://a", utils.ArchitectureArm64, 5, t0, t1),
+			},
+			wantChanged:       true,
+			wantCount:         5,
+			wantWorkloads:     1,
+			wantWorkloadCount: 5,
+			wantContainers:    1,
+			wantArchitectures: []string{utils.ArchitectureArm64},
+		},
+		{
+			name: "same errors reported again with the same count",
+			errors: []*execFormatError{
+				newError("web", "cri-o://a", utils.ArchitectureArm64, 3, t0, t1),
+				newError("web", "cri-o://a", utils.ArchitectureArm64, 3, t0, t1),
+			},
+			wantChanged:       false,
+			wantCount:         3,
+			wantWorkloads:     1,
+			wantWorkloadCount: 3,
+			wantContainers:    1,
+			wantArchitectures: []string{utils.ArchitectureArm64},
+		},
+		{
+			name: "new errors of the same container",
+			errors: []*execFormatError{
+				newError("web", "cri-o://a", utils.ArchitectureArm64, 3, t0, t1),
+				newError("web", "cri-o://a", utils.ArchitectureArm64, 2, t2, t2),
+			},
+			wantChanged:       true,
+			wantCount:         5,
+			wantWorkloads:     1,
+			wantWorkloadCount: 5,
+			wantContainers:    2,
+			wantArchitectures: []string{utils.ArchitectureArm64},
+		},
+		{
+			name: "errors on nodes of different architectures",
+			errors: []*execFormatError{
+				newError("web", "cri-o://a", utils.ArchitectureS390x, 1, t0, t0),
+				newError("web", "cri-o://b", utils.ArchitectureArm64, 1, t1, t1),
+			},
+			wantChanged:       true,
+			wantCount:         2,
+			wantWorkloads:     1,
+			wantWorkloadCount: 2,
+			wantContainers:    2,
+			wantArchitectures: []string{utils.ArchitectureArm64, utils.ArchitectureS390x},
+		},
+		{
+			name: "errors of different workloads",
+			errors: []*execFormatError{
+				newError("web", "cri-o://a", utils.ArchitectureArm64, 1, t0, t0),
+				newError("db", "cri-o://b", utils.ArchitectureArm64, 1, t1, t1),
+			},
+			wantChanged:       true,
+			wantCount:         2,
+			wantWorkloads:     2,
+			wantWorkloadCount: 1,
+			wantContainers:    1,
+			wantArchitectures: []string{utils.ArchitectureArm64},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			status := &v1beta1.ExecFormatErrorReportStatus{}
+			var changed bool
+			for _, e := range tt.errors {
+				changed = recordExecFormatError(status, e)
+			}
+			g.Expect(changed).To(Equal(tt.wantChanged))
+			g.Expect(status.Count).To(Equal(tt.wantCount))
+			g.Expect(status.LastSeen.Time).To(Equal(tt.errors[len(tt.errors)-1].lastSeen.Time))
+			g.Expect(status.Workloads).To(HaveLen(tt.wantWorkloads))
+			// The most recent workload is listed first.
+			workload := status.Workloads[0]
+			g.Expect(workload.Name).To(Equal(tt.errors[len(tt.errors)-1].workloadName))
+			g.Expect(workload.Count).To(Equal(tt.wantWorkloadCount))
+			g.Expect(workload.Containers).To(HaveLen(tt.wantContainers))
+			g.Expect(workload.NodeArchitectures).To(Equal(tt.wantArchitectures))
+		})
+	}
+}
+
+func TestRecordExecFormatError_Truncation(t *testing.T) {
+	g := NewGomegaWithT(t)
+	status := &v1beta1.ExecFormatErrorReportStatus{}
+	start := time.Now().Truncate(time.Second)
+	for i := 0; i <= v1beta1.MaxReportedExecFormatErrorWorkloads; i++ {
+		seen := metav1.NewTime(start.Add(time.Duration(i) * time.Second))
+		for j := 0; j <= v1beta1.MaxReportedExecFormatErrorContainers; j++ {
+			g.Expect(recordExecFormatError(status, &execFormatError{
+				workloadKind:  "Pod",
+				workloadName:  fmt.Sprintf("pod-%d", i),
+				containerName: "app",
+				containerID:   fmt.Sprintf("cri-o://%d-%d", i, j),
+				count:         1,
+				firstSeen:     seen,
+				lastSeen:      seen,
+			})).To(BeTrue())
+		}
+	}
+	total := int32((v1beta1.MaxReportedExecFormatErrorWorkloads + 1) * (v1beta1.MaxReportedExecFormatErrorContainers + 1))
+	g.Expect(status.Count).To(Equal(total), "the dropped workloads should still be counted")
+	g.Expect(status.Workloads).To(HaveLen(v1beta1.MaxReportedExecFormatErrorWorkloads))
+	g.Expect(status.Workloads[0].Name).To(Equal(fmt.Sprintf("pod-%d", v1beta1.MaxReportedExecFormatErrorWorkloads)))
+	g.Expect(status.Workloads[0].Count).To(BeEquivalentTo(v1beta1.MaxReportedExecFormatErrorContainers + 1))
+	g.Expect(status.Workloads[0].Containers).To(HaveLen(v1beta1.MaxReportedExecFormatErrorContainers))
+}
@@ -10,6 +10,7 @@ import (
 
 var EnoexecCounter prometheus.Counter
 var EnoexecCounterInvalid prometheus.Counter
+var EnoexecHostCounter *prometheus.CounterVec
 var onceCommon sync.Once
 
 func initMetrics() {
@@ -26,8 +27,16 @@ func initMetrics() {
 				Help: "The counter for ENoExecEvents objects that faled the reconciliation and report as pod events",
 			},
 		)
+		EnoexecHostCounter = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mto_enoexecevents_host_total",
+				Help: "The counter for exec format errors detected in the processes not running in a pod, by node",
+			},
+			[]string{"node"},
+		)
 		metrics2.Registry.MustRegister(EnoexecCounter)
 		metrics2.Registry.MustRegister(EnoexecCounterInvalid)
+		metrics2.Registry.MustRegister(EnoexecHostCounter)
 	})
 }
 
@@ -33,6 +33,7 @@ import (
 	"k8s.io/klog/v2"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -225,10 +226,16 @@ func applyResources(resources resmap.ResMap) error {
 func runManager() {
 	By("Creating the manager")
 
+	// The cache is restricted to the operator namespace, as in the operand deployment.
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                 scheme.Scheme,
 		HealthProbeBindAddress: ":4980",
 		Logger:                 suiteLog,
+		Cache: cache.Options{
+			DefaultNamespaces: map[string]cache.Config{
+				utils.Namespace(): {},
+			},
+		},
 	})
 	Expect(err).NotTo(HaveOccurred())
 
@@ -237,7 +244,7 @@ func runManager() {
 	err = mgr.AddReadyzCheck("readyz", healthz.Ping)
 	Expect(err).NotTo(HaveOccurred())
 
-	reconciler := NewReconciler(mgr.GetClient(), k8sClientSet, mgr.GetScheme(), mgr.GetEventRecorderFor("enoexecevent-controller")) //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
+	reconciler := NewReconciler(mgr.GetClient(), mgr.GetAPIReader(), k8sClientSet, mgr.GetScheme(), mgr.GetEventRecorderFor("enoexecevent-controller"), false) //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
 	if err = reconciler.SetupWithManager(mgr); err != nil {
 		suiteLog.Error(err, "unable to create controller", "controller", "ENoExecEvent")
 	}
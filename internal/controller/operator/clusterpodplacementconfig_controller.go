@@ -19,6 +19,7 @@ package operator
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	admissionv1 "k8s.io/api/admissionregistration/v1"
@@ -26,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -91,11 +93,16 @@ const (
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=clusterpodplacementconfigs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=clusterpodplacementconfigs/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=clusterpodplacementconfigs/finalizers,verbs=update
+//+kubebuilder:rbac:groups=multiarch.openshift.io,resources=architecturemismatchreports,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=multiarch.openshift.io,resources=architecturemismatchreports/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=multiarch.openshift.io,resources=execformaterrorreports,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=multiarch.openshift.io,resources=execformaterrorreports/status,verbs=get;update;patch
 
 // The operator creates ClusterRoles for operand components (buildClusterRoleController, etc.)
 // that grant these permissions. Kubernetes RBAC escalation prevention requires the creating
 // SA to hold every permission it grants, so these must also appear in the manager-role.
 //+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=use
 
@@ -105,7 +112,7 @@ const (
 //+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,resourceNames=pod-placement-mutating-webhook-configuration,verbs=get;update;patch;delete
 //+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations/status,verbs=get
 
-//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;update
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;update
 //+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch;create;delete
@@ -122,7 +129,7 @@ const (
 
 // FIND-003: Scope RBAC write to the named operand resources.
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=create;list;watch
-//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames=pod-placement-controller;pod-placement-web-hook;enoexec-event-handler-controller;enoexec-event-daemon,verbs=get;update;patch;delete
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames=pod-placement-controller;pod-placement-web-hook;enoexec-event-handler-controller;enoexec-event-daemon;exec-format-error-report-viewer,verbs=get;update;patch;delete
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles/status,verbs=get
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles/finalizers,verbs=update
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=create;list;watch
@@ -224,12 +231,15 @@ func (r *ClusterPodPlacementConfigReconciler) Reconcile(ctx context.Context, req
 		}
 
 		// Attempt to fetch the ENoExecEvent Deployment.
+		// It is not needed when the ENoExecEvents are not used: it is then deleted in reconcile.
 		eNoExecEventDeployment := &appsv1.Deployment{}
 		err := r.Get(ctx, client.ObjectKey{
 			Name:      utils.EnoexecControllerName,
 			Namespace: utils.Namespace(),
 		}, eNoExecEventDeployment)
-		if err != nil {
+		if !clusterPodPlacementConfig.Spec.Plugins.ExecFormatErrorMonitor.UsesENoExecEvents() {
+			log.V(2).Info("The ENoExecEvents are not used, skipping the finalizer of the EnoexecController Deployment")
+		} else if err != nil {
 			// If the deployment is not found, it may be created later.
 			// If any other error occurs, log it and requeue.
 			log.Error(err, "Unable to fetch EnoexecController Deployment")
@@ -296,6 +306,35 @@ func (r *ClusterPodPlacementConfigReconciler) ensureNamespaceLabels(ctx context.
 	return err
 }
 
+// countEnrolledNamespaces sets the number of namespaces enrolled in the pod placement operand and the total number of
+// namespaces in the status of the ClusterPodPlacementConfig. The namespaces ignored by the pod placement operand,
+// i.e., the operator's namespace, the kube- prefixed ones and the ignoredNamespaces, are not counted as enrolled.
+func (r *ClusterPodPlacementConfigReconciler) countEnrolledNamespaces(ctx context.Context,
+	clusterPodPlacementConfig *multiarchv1beta1.ClusterPodPlacementConfig) error {
+	// A nil namespaceSelector in a MutatingWebhookConfiguration selects all the namespaces
+	selector := labels.Everything()
+	if namespaceSelector := clusterPodPlacementConfig.EnrolledNamespaceSelector(); namespaceSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(namespaceSelector); err != nil {
+			return err
+		}
+	}
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaces); err != nil {
+		return err
+	}
+	enrolled := 0
+	for _, ns := range namespaces.Items {
+		if ns.Name != utils.Namespace() && !strings.HasPrefix(ns.Name, "kube-") &&
+			!clusterPodPlacementConfig.IsNamespaceIgnored(ns.Name) && selector.Matches(labels.Set(ns.Labels)) {
+			enrolled++
+		}
+	}
+	clusterPodPlacementConfig.Status.EnrolledNamespaces = int32(enrolled)           // #nosec G115 -- the number of namespaces fits in an int32
+	clusterPodPlacementConfig.Status.TotalNamespaces = int32(len(namespaces.Items)) // #nosec G115 -- the number of namespaces fits in an int32
+	return nil
+}
+
 // getCorrectHostmountAnyUIDSCC computes the SCC to use for the operator's wokrloads requiring hostPath mounts.
 // OpenShift 4.19 introduced a new SCC `hostmount-anyuid-v2` with elevated privileges
 // compared to `hostmount-anyuid`, allowing pods to mount specific hostPath volumes
@@ -599,6 +638,16 @@ func (r *ClusterPodPlacementConfigReconciler) handleEnoexecDelete(ctx context.Co
 			NamespacedTypedClient: r.ClientSet.AppsV1().DaemonSets(utils.Namespace()),
 			ObjName:               utils.EnoexecDaemonSet,
 		},
+		{
+			NamespacedTypedClient: r.ClientSet.CoreV1().Services(utils.Namespace()),
+			ObjName:               utils.EnoexecDaemonSet,
+		},
+	}
+	if utils.IsResourceAvailable(ctx, r.DynamicClient, monitoringv1.SchemeGroupVersion.WithResource("servicemonitors")) {
+		daemonSetRelatedObjsToDelete = append(daemonSetRelatedObjsToDelete, utils.ToDeleteRef{
+			NamespacedTypedClient: utils.NewDynamicDeleter(r.DynamicClient.Resource(schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "servicemonitors"}).Namespace(utils.Namespace())),
+			ObjName:               utils.EnoexecDaemonSet,
+		})
 	}
 	log.Info("Deleting the DaemonSet ENoExecEvent resources")
 	if err := utils.DeleteResources(ctx, daemonSetRelatedObjsToDelete); err != nil {
@@ -620,15 +669,53 @@ func (r *ClusterPodPlacementConfigReconciler) handleEnoexecDelete(ctx context.Co
 		return err
 	}
 	log.Info("eNoExecEvent DaemonSet has been deleted. Proceeding with eNoExecEvent controller cleanup.")
+	// The garbage collector stops with the plugin: the retained ENoExecEvents are deleted with the handler.
+	if err = r.deleteENoExecEventHandler(ctx, true); err != nil {
+		return err
+	}
+
+	if utils.IsResourceAvailable(ctx, r.DynamicClient, monitoringv1.SchemeGroupVersion.WithResource("servicemonitors")) {
+		log.Info("Deleting the ENoExecEvent alerting rules")
+		if err = utils.DeleteResources(ctx, []utils.ToDeleteRef{
+			{
+				NamespacedTypedClient: utils.NewDynamicDeleter(r.DynamicClient.Resource(schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "prometheusrules"}).Namespace(utils.Namespace())),
+				ObjName:               strings.ToLower(plugins.ExecFormatErrorMonitorPluginName),
+			},
+			{
+				NamespacedTypedClient: utils.NewDynamicDeleter(r.DynamicClient.Resource(schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "prometheusrules"}).Namespace(utils.Namespace())),
+				ObjName:               strings.ToLower(utils.ExecFormatErrorsDetected),
+			},
+		}); err != nil {
+			log.Error(err, "Unable to delete the alerting rules")
+			return err
+		}
+	}
+	log.Info("Removing the ENoExecEvent finalizer from the ClusterPodPlacementConfig")
+	if controllerutil.RemoveFinalizer(clusterPodPlacementConfig, utils.ExecFormatErrorFinalizerName) {
+		if err = r.Update(ctx, clusterPodPlacementConfig); err != nil {
+			log.Error(err, "Unable to remove finalizers.",
+				clusterPodPlacementConfig.Kind, clusterPodPlacementConfig.Name)
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteENoExecEventHandler deletes the ENoExecEvent handler and its resources, once it reconciled the remaining
+// ENoExecEvents. It is used when the ExecFormatErrorMonitor plugin is disabled, after the daemons are deleted, and when
+// the daemons no longer store the exec format errors as ENoExecEvents. The ENoExecEvents retained after their
+// reconciliation are deleted if deleteRetained is true, and left to the garbage collector otherwise.
+func (r *ClusterPodPlacementConfigReconciler) deleteENoExecEventHandler(ctx context.Context, deleteRetained bool) error {
+	log := ctrllog.FromContext(ctx, "operation", "deleteENoExecEventHandler")
 	enoexecEventList := &multiarchv1beta1.ENoExecEventList{}
-	err = r.List(ctx, enoexecEventList, client.InNamespace(utils.Namespace()))
+	err := r.List(ctx, enoexecEventList, client.InNamespace(utils.Namespace()))
 	if err != nil {
 		log.Error(err, "Failed to list ENoExecEvent resources")
 		return err
 	}
 
 	// Delete errored ENoExecEvent resources and count remaining non-errored ones
-	nonErroredCount, _ := r.deleteErroredENoExecEvents(ctx, enoexecEventList)
+	nonErroredCount, _ := r.deleteErroredENoExecEvents(ctx, enoexecEventList, deleteRetained)
 
 	// Only block cleanup if there are non-errored ENoExecEvent resources.
 	// The enoexec-controller deployment is still running at this point so it can
@@ -674,16 +761,16 @@ func (r *ClusterPodPlacementConfigReconciler) handleEnoexecDelete(ctx context.Co
 			ObjName:               utils.EnoexecControllerName,
 		},
 		{
-			NamespacedTypedClient: r.ClientSet.RbacV1().ClusterRoleBindings(),
-			ObjName:               utils.EnoexecControllerName,
+			NamespacedTypedClient: r.ClientSet.RbacV1().ClusterRoles(),
+			ObjName:               utils.ExecFormatErrorReportViewer,
 		},
 		{
-			NamespacedTypedClient: r.ClientSet.CoreV1().ServiceAccounts(utils.Namespace()),
+			NamespacedTypedClient: r.ClientSet.RbacV1().ClusterRoleBindings(),
 			ObjName:               utils.EnoexecControllerName,
 		},
 		{
 			NamespacedTypedClient: r.ClientSet.CoreV1().ServiceAccounts(utils.Namespace()),
-			ObjName:               utils.EnoexecDaemonSet,
+			ObjName:               utils.EnoexecControllerName,
 		},
 		{
 			NamespacedTypedClient: r.ClientSet.CoreV1().Services(utils.Namespace()),
@@ -695,12 +782,6 @@ func (r *ClusterPodPlacementConfigReconciler) handleEnoexecDelete(ctx context.Co
 		deploymentRelatedObjsToDelete = append(deploymentRelatedObjsToDelete, utils.ToDeleteRef{
 			NamespacedTypedClient: utils.NewDynamicDeleter(r.DynamicClient.Resource(schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "servicemonitors"}).Namespace(utils.Namespace())),
 			ObjName:               utils.EnoexecControllerName,
-		}, utils.ToDeleteRef{
-			NamespacedTypedClient: utils.NewDynamicDeleter(r.DynamicClient.Resource(schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "prometheusrules"}).Namespace(utils.Namespace())),
-			ObjName:               plugins.ExecFormatErrorMonitorPluginName,
-		}, utils.ToDeleteRef{
-			NamespacedTypedClient: utils.NewDynamicDeleter(r.DynamicClient.Resource(schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "prometheusrules"}).Namespace(utils.Namespace())),
-			ObjName:               utils.ExecFormatErrorsDetected,
 		})
 	}
 
@@ -709,11 +790,16 @@ func (r *ClusterPodPlacementConfigReconciler) handleEnoexecDelete(ctx context.Co
 		log.Error(err, "Unable to delete deployment resources")
 		return err
 	}
-	log.Info("Removing the ENoExecEvent finalizer from the ClusterPodPlacementConfig")
-	if controllerutil.RemoveFinalizer(clusterPodPlacementConfig, utils.ExecFormatErrorFinalizerName) {
-		if err = r.Update(ctx, clusterPodPlacementConfig); err != nil {
-			log.Error(err, "Unable to remove finalizers.",
-				clusterPodPlacementConfig.Kind, clusterPodPlacementConfig.Name)
+
+	// The ExecFormatErrorReports are no longer updated without the ENoExecEvent handler.
+	reportList := &multiarchv1beta1.ExecFormatErrorReportList{}
+	if err = r.List(ctx, reportList); err != nil {
+		log.Error(err, "Failed to list ExecFormatErrorReport resources")
+		return err
+	}
+	for i := range reportList.Items {
+		if err = r.Delete(ctx, &reportList.Items[i]); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete ExecFormatErrorReport", "namespace", reportList.Items[i].Namespace)
 			return err
 		}
 	}
@@ -732,6 +818,10 @@ func (r *ClusterPodPlacementConfigReconciler) reconcile(ctx context.Context, clu
 		log.Error(err, "Unable to ensure namespace labels")
 		return errorutils.NewAggregate([]error{err, r.updateStatus(ctx, clusterPodPlacementConfig)})
 	}
+	if err := r.countEnrolledNamespaces(ctx, clusterPodPlacementConfig); err != nil {
+		// The namespaces count is informational: the reconciliation continues and the count is retried next time.
+		log.Error(err, "Unable to count the enrolled namespaces")
+	}
 	clusterPodPlacementConfigObjects, err := r.buildPodPlacementConfigObjects(clusterPodPlacementConfig, ctx)
 	if err != nil {
 		return err
@@ -791,7 +881,17 @@ func (r *ClusterPodPlacementConfigReconciler) reconcile(ctx context.Context, clu
 		return errorutils.NewAggregate([]error{err, r.updateStatus(ctx, clusterPodPlacementConfig)})
 	}
 
-	return r.updateStatus(ctx, clusterPodPlacementConfig)
+	statusErr := r.updateStatus(ctx, clusterPodPlacementConfig)
+	if clusterPodPlacementConfig.PluginsEnabled(common.ExecFormatErrorMonitorPluginName) &&
+		!clusterPodPlacementConfig.Spec.Plugins.ExecFormatErrorMonitor.UsesENoExecEvents() {
+		// The daemons were updated to store the exec format errors in another backend: the ENoExecEvent handler is
+		// deleted once it reconciled the ENoExecEvents they created before. The history of the retained ENoExecEvents
+		// is kept until the garbage collector expires them.
+		if err := r.deleteENoExecEventHandler(ctx, false); err != nil {
+			return err
+		}
+	}
+	return statusErr
 }
 
 // updateStatus updates the status of the ClusterPodPlacementConfig object.
@@ -879,49 +979,15 @@ func (r *ClusterPodPlacementConfigReconciler) buildPodPlacementConfigObjects(clu
 func (r *ClusterPodPlacementConfigReconciler) buildENoExecEventObjects(ctx context.Context, clusterPodPlacementConfig *multiarchv1beta1.ClusterPodPlacementConfig) ([]client.Object, error) {
 	log := ctrllog.FromContext(ctx)
 	logVerbosityLevel := clusterPodPlacementConfig.Spec.LogVerbosity.ToZapLevelInt()
+	monitor := clusterPodPlacementConfig.Spec.Plugins.ExecFormatErrorMonitor
+	serviceMonitorsAvailable := utils.IsResourceAvailable(ctx, r.DynamicClient, monitoringv1.SchemeGroupVersion.WithResource("servicemonitors"))
 
-	log.Info("Starting ENoExecEvent Controller")
+	log.Info("Starting ENoExecEvent DaemonSet", "storageBackend", monitor.StorageBackend)
 	objects := []client.Object{
-		buildService(utils.EnoexecControllerName),
-		buildServiceAccount(utils.EnoexecControllerName),
+		buildServiceENoExecEventDaemon(),
 		buildServiceAccount(utils.EnoexecDaemonSet),
-
-		// Then Roles and ClusterRoles
-		buildClusterRoleENoExecEventsController(),
-		buildRoleENoExecEventController(),
-		buildClusterRoleENoExecEventsDaemonSet(),
+		buildClusterRoleENoExecEventsDaemonSet(monitor),
 		buildRoleENoExecEventDaemonSet(),
-
-		buildClusterRoleBinding(
-			utils.EnoexecControllerName,
-			rbacv1.RoleRef{
-				APIGroup: rbacv1.GroupName,
-				Kind:     clusterRoleKind,
-				Name:     utils.EnoexecControllerName,
-			},
-			[]rbacv1.Subject{
-				{
-					Kind:      serviceAccountKind,
-					Name:      utils.EnoexecControllerName,
-					Namespace: utils.Namespace(),
-				},
-			},
-		),
-		buildRoleBinding(
-			utils.EnoexecControllerName,
-			rbacv1.RoleRef{
-				APIGroup: rbacv1.GroupName,
-				Kind:     roleKind,
-				Name:     utils.EnoexecControllerName,
-			},
-			[]rbacv1.Subject{
-				{
-					Kind:      serviceAccountKind,
-					Name:      utils.EnoexecControllerName,
-					Namespace: utils.Namespace(),
-				},
-			},
-		),
 		buildClusterRoleBinding(
 			utils.EnoexecDaemonSet,
 			rbacv1.RoleRef{
@@ -952,16 +1018,60 @@ func (r *ClusterPodPlacementConfigReconciler) buildENoExecEventObjects(ctx conte
 				},
 			},
 		),
-		buildDeploymentENoExecEventHandler(logVerbosityLevel),
-		buildDaemonSetENoExecEvent(utils.EnoexecDaemonSet, utils.EnoexecDaemonSet, logVerbosityLevel),
+		buildDaemonSetENoExecEvent(utils.EnoexecDaemonSet, utils.EnoexecDaemonSet, logVerbosityLevel, monitor),
+	}
+	// The ENoExecEvent handler is only needed when the daemons store the exec format errors as ENoExecEvents
+	if monitor.UsesENoExecEvents() {
+		log.Info("Starting ENoExecEvent Controller")
+		objects = append(objects,
+			buildService(utils.EnoexecControllerName),
+			buildServiceAccount(utils.EnoexecControllerName),
+			buildClusterRoleENoExecEventsController(),
+			buildClusterRoleExecFormatErrorReportViewer(),
+			buildRoleENoExecEventController(),
+			buildClusterRoleBinding(
+				utils.EnoexecControllerName,
+				rbacv1.RoleRef{
+					APIGroup: rbacv1.GroupName,
+					Kind:     clusterRoleKind,
+					Name:     utils.EnoexecControllerName,
+				},
+				[]rbacv1.Subject{
+					{
+						Kind:      serviceAccountKind,
+						Name:      utils.EnoexecControllerName,
+						Namespace: utils.Namespace(),
+					},
+				},
+			),
+			buildRoleBinding(
+				utils.EnoexecControllerName,
+				rbacv1.RoleRef{
+					APIGroup: rbacv1.GroupName,
+					Kind:     roleKind,
+					Name:     utils.EnoexecControllerName,
+				},
+				[]rbacv1.Subject{
+					{
+						Kind:      serviceAccountKind,
+						Name:      utils.EnoexecControllerName,
+						Namespace: utils.Namespace(),
+					},
+				},
+			),
+			buildDeploymentENoExecEventHandler(logVerbosityLevel, monitor),
+		)
+		if serviceMonitorsAvailable {
+			objects = append(objects, buildServiceMonitor(utils.EnoexecControllerName))
+		}
 	}
 	// If the servicemonitors.monitoring.coreos.com CRD is available, we create the ServiceMonitor objects
-	if utils.IsResourceAvailable(ctx, r.DynamicClient, monitoringv1.SchemeGroupVersion.WithResource("servicemonitors")) {
+	if serviceMonitorsAvailable {
 		log.V(1).Info("Creating ServiceMonitors")
 		objects = append(objects,
-			buildServiceMonitor(utils.EnoexecControllerName),
-			buildExecFormatErrorAvailabilityAlertRule(),
-			buildExecFormatErrorsDetectedAlertRule(),
+			buildServiceMonitor(utils.EnoexecDaemonSet),
+			buildExecFormatErrorAvailabilityAlertRule(monitor),
+			buildExecFormatErrorsDetectedAlertRule(monitor),
 		)
 	} else {
 		log.V(1).Info("servicemonitoring.monitoring.coreos.com is not available. Skipping the creation of the ServiceMonitors")
@@ -992,12 +1102,24 @@ func isDeploymentUpToDate(deployment *appsv1.Deployment) bool {
 		deployment.Status.ObservedGeneration == deployment.Generation
 }
 
-// deleteErroredENoExecEvents deletes ENoExecEvent resources that are marked with an error label.
-// Returns the count of non-errored and errored events found.
-func (r *ClusterPodPlacementConfigReconciler) deleteErroredENoExecEvents(ctx context.Context, enoexecEventList *multiarchv1beta1.ENoExecEventList) (nonErroredCount, erroredCount int) {
+// deleteErroredENoExecEvents deletes ENoExecEvent resources that are marked with an error label, and, if deleteRetained
+// is true, the ones retained after their reconciliation by the ENoExecEvent handler.
+// Returns the count of non-errored events waiting for reconciliation and of errored events found.
+func (r *ClusterPodPlacementConfigReconciler) deleteErroredENoExecEvents(ctx context.Context, enoexecEventList *multiarchv1beta1.ENoExecEventList,
+	deleteRetained bool) (nonErroredCount, erroredCount int) {
 	log := ctrllog.FromContext(ctx)
 	for i := range enoexecEventList.Items {
 		enoexecEvent := &enoexecEventList.Items[i]
+		// The retained ENoExecEvents were already reconciled and do not need to wait for the handler
+		if enoexecEvent.Status.IsHandled() {
+			if !deleteRetained {
+				continue
+			}
+			if deleteErr := r.Delete(ctx, enoexecEvent); client.IgnoreNotFound(deleteErr) != nil {
+				log.Error(deleteErr, "Failed to delete retained ENoExecEvent", "name", enoexecEvent.Name)
+			}
+			continue
+		}
 		// Check if this ENoExecEvent is marked as errored
 		if enoexecEvent.Labels == nil {
 			nonErroredCount++
@@ -1054,6 +1176,21 @@ func (r *ClusterPodPlacementConfigReconciler) SetupWithManager(mgr ctrl.Manager)
 				},
 			}),
 		).
+		// Watch the namespaces to keep the count of the enrolled namespaces up to date.
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				return []reconcile.Request{
+					{
+						NamespacedName: types.NamespacedName{
+							Name: common.SingletonResourceObjectName,
+						},
+					},
+				}
+			}),
+			// The creations and deletions of namespaces are also accepted by the predicate.
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
 		Owns(&appsv1.Deployment{}).
 		Owns(&appsv1.DaemonSet{}).
 		Owns(&corev1.Service{}).
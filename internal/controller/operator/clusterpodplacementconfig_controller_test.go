@@ -19,10 +19,12 @@ package operator
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +33,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
 	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/enoexecevent/handler"
 	"github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
@@ -317,6 +320,10 @@ var _ = Describe("internal/Controller/ClusterPodPlacementConfig/ClusterPodPlacem
 					}), mw)
 					g.Expect(err).NotTo(HaveOccurred(), "failed to get mutating webhook configuration "+utils.PodMutatingWebhookConfigurationName, err)
 					g.Expect(mw.Webhooks[0].NamespaceSelector).To(Equal(ppc.Spec.NamespaceSelector))
+					g.Expect(mw.Webhooks[0].ObjectSelector.MatchExpressions).To(ContainElement(metav1.LabelSelectorRequirement{
+						Key:      utils.PodOptOutLabel,
+						Operator: metav1.LabelSelectorOpDoesNotExist,
+					}))
 				}).Should(Succeed(), "the deployment "+utils.PodPlacementControllerName+" should be updated")
 			})
 			It("Should have ClusterPodPlacementConfig finalizers", func() {
@@ -736,6 +743,68 @@ var _ = Describe("internal/Controller/ClusterPodPlacementConfig/ClusterPodPlacem
 			}).Should(Succeed(), "finalizer should be removed after cleanup")
 		})
 	})
+	Context("is handling the ExecFormatErrorMonitor storage backends", func() {
+		BeforeEach(func() {
+			By("Creating the ClusterPodPlacementConfig with ExecFormatErrorMonitor enabled")
+			err := k8sClient.Create(ctx, builder.NewClusterPodPlacementConfig().WithName(common.SingletonResourceObjectName).
+				WithExecFormatErrorMonitor(true).Build())
+			Expect(err).NotTo(HaveOccurred(), "failed to create ClusterPodPlacementConfig", err)
+			validateReconcile(framework.MainPlugin, framework.ENoExecPlugin)
+		})
+		AfterEach(func() {
+			By("Deleting the ClusterPodPlacementConfig")
+			err := k8sClient.Delete(ctx, builder.NewClusterPodPlacementConfig().WithName(common.SingletonResourceObjectName).Build())
+			Expect(err).NotTo(HaveOccurred(), "failed to delete ClusterPodPlacementConfig", err)
+			Eventually(framework.ValidateDeletion(k8sClient, ctx, framework.MainPlugin, framework.ENoExecPlugin)).Should(Succeed(), "the ClusterPodPlacementConfig should be deleted")
+		})
+		It("should delete the ENoExecEvent handler when the Event storage backend is selected", func() {
+			By("Selecting the Event storage backend")
+			cppc := &v1beta1.ClusterPodPlacementConfig{}
+			err := k8sClient.Get(ctx, crclient.ObjectKey{Name: common.SingletonResourceObjectName}, cppc)
+			Expect(err).NotTo(HaveOccurred())
+			cppc.Spec.Plugins.ExecFormatErrorMonitor.StorageBackend = plugins.StorageBackendEvent
+			Expect(k8sClient.Update(ctx, cppc)).To(Succeed(), "failed to update ClusterPodPlacementConfig")
+
+			By("Verifying the daemons use the Event storage backend and can write the events")
+			Eventually(func(g Gomega) {
+				ds := &appsv1.DaemonSet{}
+				err := k8sClient.Get(ctx, crclient.ObjectKey{Name: utils.EnoexecDaemonSet, Namespace: utils.Namespace()}, ds)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(ds.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--storage-backend=Event"))
+				cr := &rbacv1.ClusterRole{}
+				err = k8sClient.Get(ctx, crclient.ObjectKey{Name: utils.EnoexecDaemonSet}, cr)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(cr.Rules).To(ContainElement(HaveField("Resources", ContainElement("events"))))
+			}).Should(Succeed(), "the DaemonSet should use the Event storage backend")
+
+			By("Verifying the ENoExecEvent handler is deleted")
+			Eventually(func(g Gomega) {
+				err := k8sClient.Get(ctx, crclient.ObjectKey{Name: utils.EnoexecControllerName, Namespace: utils.Namespace()},
+					&appsv1.Deployment{})
+				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "the ENoExecEvent handler should be deleted", err)
+			}).Should(Succeed(), "the ENoExecEvent handler should be deleted")
+		})
+		It("should make the ENoExecEvent handler retain the ENoExecEvents when a retention is set", func() {
+			By("Setting the retention of the ENoExecEvents")
+			cppc := &v1beta1.ClusterPodPlacementConfig{}
+			err := k8sClient.Get(ctx, crclient.ObjectKey{Name: common.SingletonResourceObjectName}, cppc)
+			Expect(err).NotTo(HaveOccurred())
+			cppc.Spec.Plugins.ExecFormatErrorMonitor.Retention = &plugins.ENoExecEventRetention{MaxAgeHours: 24}
+			Expect(k8sClient.Update(ctx, cppc)).To(Succeed(), "failed to update ClusterPodPlacementConfig")
+
+			By("Verifying the ENoExecEvent handler retains the ENoExecEvents and can record their outcome")
+			Eventually(func(g Gomega) {
+				d := &appsv1.Deployment{}
+				err := k8sClient.Get(ctx, crclient.ObjectKey{Name: utils.EnoexecControllerName, Namespace: utils.Namespace()}, d)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--retain-enoexec-events"))
+				role := &rbacv1.Role{}
+				err = k8sClient.Get(ctx, crclient.ObjectKey{Name: utils.EnoexecControllerName, Namespace: utils.Namespace()}, role)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(role.Rules).To(ContainElement(HaveField("Resources", ContainElement(v1beta1.ENoExecEventResource+"/status"))))
+			}).Should(Succeed(), "the ENoExecEvent handler should retain the ENoExecEvents")
+		})
+	})
 	Context("the webhook shoud deny PodPlacementConfig creation", func() {
 		It("when the ClusterPodPlacementConfig doesn't exist", func() {
 			By("Ensure the ClusterPodPlacementConfig doesn't exist")
@@ -1011,7 +1080,7 @@ var _ = Describe("internal/Controller/ClusterPodPlacementConfig/ClusterPodPlacem
 			Expect(k8sClient.List(ctx, enoexecEventList, crclient.InNamespace(utils.Namespace()))).To(Succeed())
 
 			By("Calling deleteErroredENoExecEvents")
-			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, enoexecEventList)
+			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, enoexecEventList, true)
 
 			By("Verifying the counts")
 			Expect(nonErroredCount).To(Equal(2), "should have 2 non-errored events")
@@ -1050,9 +1119,57 @@ var _ = Describe("internal/Controller/ClusterPodPlacementConfig/ClusterPodPlacem
 			Expect(k8sClient.Delete(ctx, nonErroredENEE2)).To(Succeed())
 		})
 
+		It("should delete the retained ENoExecEvents without counting them", func() {
+			By("Creating a retained ENoExecEvent")
+			retainedENEE := builder.NewENoExecEvent().
+				WithName(framework.GenerateName()).
+				WithNamespace(utils.Namespace()).
+				Build()
+			Expect(k8sClient.Create(ctx, retainedENEE)).To(Succeed())
+			retainedENEE.Status = builder.NewENoExecEvent().
+				WithOutcome(v1beta1.ENoExecEventOutcomePublished, "", time.Now()).Build().Status
+			Expect(k8sClient.Status().Update(ctx, retainedENEE)).To(Succeed())
+
+			enoexecEventList := &v1beta1.ENoExecEventList{Items: []v1beta1.ENoExecEvent{*retainedENEE}}
+			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, enoexecEventList, true)
+			Expect(nonErroredCount).To(Equal(0), "should not wait for the retained events")
+			Expect(erroredCount).To(Equal(0))
+
+			By("Verifying the retained event is deleted")
+			Eventually(func(g Gomega) {
+				err := k8sClient.Get(ctx, crclient.ObjectKeyFromObject(retainedENEE), &v1beta1.ENoExecEvent{})
+				g.Expect(errors.IsNotFound(err)).To(BeTrue(), "retained event should be deleted")
+			}).Should(Succeed())
+		})
+
+		It("should keep the retained ENoExecEvents for the garbage collector", func() {
+			By("Creating a retained ENoExecEvent")
+			retainedENEE := builder.NewENoExecEvent().
+				WithName(framework.GenerateName()).
+				WithNamespace(utils.Namespace()).
+				Build()
+			Expect(k8sClient.Create(ctx, retainedENEE)).To(Succeed())
+			retainedENEE.Status = builder.NewENoExecEvent().
+				WithOutcome(v1beta1.ENoExecEventOutcomePublished, "", time.Now()).Build().Status
+			Expect(k8sClient.Status().Update(ctx, retainedENEE)).To(Succeed())
+
+			enoexecEventList := &v1beta1.ENoExecEventList{Items: []v1beta1.ENoExecEvent{*retainedENEE}}
+			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, enoexecEventList, false)
+			Expect(nonErroredCount).To(Equal(0), "should not wait for the retained events")
+			Expect(erroredCount).To(Equal(0))
+
+			By("Verifying the retained event is kept")
+			Consistently(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, crclient.ObjectKeyFromObject(retainedENEE), &v1beta1.ENoExecEvent{})).To(Succeed())
+			}).WithTimeout(2 * time.Second).Should(Succeed())
+
+			By("Cleaning up")
+			Expect(k8sClient.Delete(ctx, retainedENEE)).To(Succeed())
+		})
+
 		It("should handle empty list gracefully", func() {
 			emptyList := &v1beta1.ENoExecEventList{}
-			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, emptyList)
+			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, emptyList, true)
 			Expect(nonErroredCount).To(Equal(0))
 			Expect(erroredCount).To(Equal(0))
 		})
@@ -1068,7 +1185,7 @@ var _ = Describe("internal/Controller/ClusterPodPlacementConfig/ClusterPodPlacem
 			enoexecEventList := &v1beta1.ENoExecEventList{}
 			Expect(k8sClient.List(ctx, enoexecEventList, crclient.InNamespace(utils.Namespace()))).To(Succeed())
 
-			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, enoexecEventList)
+			nonErroredCount, erroredCount := reconciler.deleteErroredENoExecEvents(ctx, enoexecEventList, true)
 			Expect(nonErroredCount).To(BeNumerically(">", 0), "should count events with no labels as non-errored")
 			Expect(erroredCount).To(Equal(0))
 
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// enoexecEventGarbageCollectorPeriod is the period at which the garbage collector deletes the expired ENoExecEvents.
+const enoexecEventGarbageCollectorPeriod = 5 * time.Minute
+
+// ENoExecEventGarbageCollector deletes the ENoExecEvents retained after their reconciliation by the ENoExecEvent
+// handler once they exceed the retention policy of the ExecFormatErrorMonitor plugin. The retained ENoExecEvents are
+// all deleted if the retention is disabled. The ENoExecEvents not reconciled yet are never deleted.
+type ENoExecEventGarbageCollector struct {
+	client client.Client
+}
+
+func NewENoExecEventGarbageCollector(client client.Client) *ENoExecEventGarbageCollector {
+	return &ENoExecEventGarbageCollector{
+		client: client,
+	}
+}
+
+// NeedLeaderElection makes the garbage collector run on the leader replica only.
+func (gc *ENoExecEventGarbageCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (gc *ENoExecEventGarbageCollector) Start(ctx context.Context) error {
+	log := ctrllog.FromContext(ctx, "runnable", "ENoExecEventGarbageCollector")
+	ctx = ctrllog.IntoContext(ctx, log)
+	log.Info("Starting the ENoExecEvent garbage collector")
+	wait.UntilWithContext(ctx, gc.collect, enoexecEventGarbageCollectorPeriod)
+	log.Info("Stopping the ENoExecEvent garbage collector")
+	return nil
+}
+
+// collect deletes the expired ENoExecEvents. It does nothing if the ExecFormatErrorMonitor plugin is disabled or the
+// ClusterPodPlacementConfig is being deleted, as the operator deletes all the ENoExecEvents while disabling the plugin.
+func (gc *ENoExecEventGarbageCollector) collect(ctx context.Context) {
+	log := ctrllog.FromContext(ctx)
+	cppc := &multiarchv1beta1.ClusterPodPlacementConfig{}
+	if err := gc.client.Get(ctx, client.ObjectKey{Name: common.SingletonResourceObjectName}, cppc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Unable to get the ClusterPodPlacementConfig")
+		}
+		return
+	}
+	if !cppc.PluginsEnabled(common.ExecFormatErrorMonitorPluginName) || !cppc.DeletionTimestamp.IsZero() {
+		return
+	}
+	enoexecEventList := &multiarchv1beta1.ENoExecEventList{}
+	if err := gc.client.List(ctx, enoexecEventList, client.InNamespace(utils.Namespace())); err != nil {
+		log.Error(err, "Unable to list the ENoExecEvents")
+		return
+	}
+	monitor := cppc.Spec.Plugins.ExecFormatErrorMonitor
+	var retention *plugins.ENoExecEventRetention
+	if monitor.RetainsENoExecEvents() {
+		retention = monitor.Retention
+	}
+	deleted := 0
+	for _, enoexecEvent := range expiredENoExecEvents(enoexecEventList.Items, retention, time.Now()) {
+		if err := gc.client.Delete(ctx, enoexecEvent); client.IgnoreNotFound(err) != nil {
+			// A failed deletion is retried at the next collection.
+			log.Error(err, "Unable to delete the expired ENoExecEvent", "name", enoexecEvent.Name)
+			continue
+		}
+		deleted++
+	}
+	if deleted > 0 {
+		log.Info("Deleted the expired ENoExecEvents", "count", deleted)
+	}
+}
+
+// expiredENoExecEvents returns the reconciled ENoExecEvents that exceed the retention: the ones reconciled for longer
+// than its max age, and the ones beyond the most recently reconciled ones of each pod namespace. All the reconciled
+// ENoExecEvents are expired if the retention is nil.
+func expiredENoExecEvents(enoexecEvents []multiarchv1beta1.ENoExecEvent, retention *plugins.ENoExecEventRetention,
+	now time.Time) []*multiarchv1beta1.ENoExecEvent {
+	expired := make([]*multiarchv1beta1.ENoExecEvent, 0)
+	retainedByNamespace := make(map[string][]*multiarchv1beta1.ENoExecEvent)
+	maxAge := retention.MaxAge()
+	for i := range enoexecEvents {
+		enoexecEvent := &enoexecEvents[i]
+		if !enoexecEvent.Status.IsHandled() {
+			continue
+		}
+		if retention == nil || (maxAge > 0 && now.Sub(enoexecEvent.Status.HandledAt.Time) > maxAge) {
+			expired = append(expired, enoexecEvent)
+			continue
+		}
+		// The node-scoped ENoExecEvents have no pod namespace and are counted together.
+		namespace := enoexecEvent.Status.PodNamespace
+		retainedByNamespace[namespace] = append(retainedByNamespace[namespace], enoexecEvent)
+	}
+	if retention == nil || retention.MaxPerNamespace <= 0 {
+		return expired
+	}
+	for _, retained := range retainedByNamespace {
+		if len(retained) <= int(retention.MaxPerNamespace) {
+			continue
+		}
+		sort.SliceStable(retained, func(i, j int) bool {
+			return retained[i].Status.HandledAt.After(retained[j].Status.HandledAt.Time)
+		})
+		expired = append(expired, retained[retention.MaxPerNamespace:]...)
+	}
+	return expired
+}
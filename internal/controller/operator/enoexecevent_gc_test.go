@@ -0,0 +1,64 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
+)
+
+func TestExpiredENoExecEvents(t *testing.T) {
+	now := time.Now()
+	handled := func(name, podNamespace string, age time.Duration) v1beta1.ENoExecEvent {
+		return *builder.NewENoExecEvent().WithName(name).WithPodNamespace(podNamespace).
+			WithOutcome(v1beta1.ENoExecEventOutcomePublished, "", now.Add(-age)).Build()
+	}
+	enoexecEvents := []v1beta1.ENoExecEvent{
+		handled("ns1-recent", "ns1", time.Minute),
+		handled("ns1-older", "ns1", time.Hour),
+		handled("ns1-oldest", "ns1", 2*time.Hour),
+		handled("ns2-old", "ns2", 48*time.Hour),
+		handled("node-recent", "", time.Minute),
+		*builder.NewENoExecEvent().WithName("pending").WithPodNamespace("ns1").Build(),
+	}
+	tests := []struct {
+		name      string
+		retention *plugins.ENoExecEventRetention
+		want      []string
+	}{
+		{
+			name:      "retention disabled",
+			retention: nil,
+			want:      []string{"ns1-recent", "ns1-older", "ns1-oldest", "ns2-old", "node-recent"},
+		},
+		{
+			name:      "max age",
+			retention: &plugins.ENoExecEventRetention{MaxAgeHours: 24},
+			want:      []string{"ns2-old"},
+		},
+		{
+			name:      "max per namespace",
+			retention: &plugins.ENoExecEventRetention{MaxPerNamespace: 1},
+			want:      []string{"ns1-older", "ns1-oldest"},
+		},
+		{
+			name:      "max age and max per namespace",
+			retention: &plugins.ENoExecEventRetention{MaxAgeHours: 24, MaxPerNamespace: 2},
+			want:      []string{"ns1-oldest", "ns2-old"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			names := make([]string, 0)
+			for _, enoexecEvent := range expiredENoExecEvents(enoexecEvents, tt.retention, now) {
+				names = append(names, enoexecEvent.Name)
+			}
+			g.Expect(names).To(ConsistOf(tt.want))
+		})
+	}
+}
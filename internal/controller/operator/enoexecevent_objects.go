@@ -18,6 +18,29 @@ import (
 	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
 )
 
+const (
+	// criSocketMountPath is the path where the configured CRI socket is mounted in the ENoExecEvent daemon pods.
+	criSocketMountPath = "/host/cri.sock"
+	// hostRunMountPath is the path where the directories of the CRI sockets in the /run directory of the host are
+	// mounted in the ENoExecEvent daemon pods, for the daemon to autodetect the CRI socket. It must match the path used
+	// by the daemon.
+	hostRunMountPath = "/host/run"
+	// enoexecDaemonMetricsPort is the port of the metrics endpoint of the ENoExecEvent daemon pods.
+	enoexecDaemonMetricsPort int32 = 8443
+)
+
+// criSocketHostDirs are the directories, in the /run directory of the host, of the sockets of the CRI runtimes
+// autodetected by the ENoExecEvent daemon, by volume name. The cri-dockerd socket is created directly in /run: it is not
+// autodetected and must be configured in the ExecFormatErrorMonitor plugin.
+var criSocketHostDirs = []struct {
+	name string
+	path string
+}{
+	{"cri-socket-crio", "crio"},
+	{"cri-socket-containerd", "containerd"},
+	{"cri-socket-k3s", "k3s/containerd"},
+}
+
 func buildClusterRoleENoExecEventsController() *rbacv1.ClusterRole {
 	return buildClusterRole(utils.EnoexecControllerName, []rbacv1.PolicyRule{
 		{
@@ -35,6 +58,16 @@ func buildClusterRoleENoExecEventsController() *rbacv1.ClusterRole {
 			Resources: []string{"pods", "pods/status"},
 			Verbs:     []string{UPDATE},
 		},
+		{
+			APIGroups: []string{v1beta1.GroupVersion.Group},
+			Resources: []string{v1beta1.ExecFormatErrorReportResource},
+			Verbs:     []string{LIST, WATCH, GET, CREATE},
+		},
+		{
+			APIGroups: []string{v1beta1.GroupVersion.Group},
+			Resources: []string{v1beta1.ExecFormatErrorReportResource + "/status"},
+			Verbs:     []string{GET, UPDATE},
+		},
 		{
 			APIGroups: []string{"authentication.k8s.io"},
 			Resources: []string{"tokenreviews"},
@@ -48,6 +81,23 @@ func buildClusterRoleENoExecEventsController() *rbacv1.ClusterRole {
 	})
 }
 
+// buildClusterRoleExecFormatErrorReportViewer returns the ClusterRole aggregated to the view, edit and admin
+// ClusterRoles, so that the users of a namespace can read its ExecFormatErrorReport. The reports can only be written
+// by the ENoExecEvent handler.
+func buildClusterRoleExecFormatErrorReportViewer() *rbacv1.ClusterRole {
+	clusterRole := buildClusterRole(utils.ExecFormatErrorReportViewer, []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{v1beta1.GroupVersion.Group},
+			Resources: []string{v1beta1.ExecFormatErrorReportResource, v1beta1.ExecFormatErrorReportResource + "/status"},
+			Verbs:     []string{LIST, WATCH, GET},
+		},
+	})
+	for _, role := range []string{"view", "edit", "admin"} {
+		clusterRole.Labels["rbac.authorization.k8s.io/aggregate-to-"+role] = utils.True
+	}
+	return clusterRole
+}
+
 func buildRoleENoExecEventController() *rbacv1.Role {
 	return &rbacv1.Role{
 		ObjectMeta: metav1.ObjectMeta{
@@ -60,6 +110,11 @@ func buildRoleENoExecEventController() *rbacv1.Role {
 				Resources: []string{v1beta1.ENoExecEventResource},
 				Verbs:     []string{LIST, WATCH, GET, UPDATE, PATCH, CREATE, DELETE},
 			},
+			{
+				APIGroups: []string{v1beta1.GroupVersion.Group},
+				Resources: []string{v1beta1.ENoExecEventResource + "/status"},
+				Verbs:     []string{UPDATE},
+			},
 			{
 				APIGroups: []string{""},
 				Resources: []string{"configmaps"},
@@ -74,15 +129,44 @@ func buildRoleENoExecEventController() *rbacv1.Role {
 	}
 }
 
-func buildClusterRoleENoExecEventsDaemonSet() *rbacv1.ClusterRole {
-	return buildClusterRole(utils.EnoexecDaemonSet, []rbacv1.PolicyRule{
+// buildClusterRoleENoExecEventsDaemonSet returns the ClusterRole of the ENoExecEvent daemon. The daemon can only write
+// the events of the pods and nodes when it stores the exec format errors as events.
+func buildClusterRoleENoExecEventsDaemonSet(monitor *plugins.ExecFormatErrorMonitor) *rbacv1.ClusterRole {
+	rules := []rbacv1.PolicyRule{
 		{
 			APIGroups:     []string{"security.openshift.io"},
 			Resources:     []string{"securitycontextconstraints"},
 			ResourceNames: []string{"privileged"},
 			Verbs:         []string{USE},
 		},
-	})
+		// The daemon lists and watches the pods of its node only, with the spec.nodeName field selector. The grant is
+		// cluster-wide because RBAC cannot restrict a rule to a field selector, and the Node authorizer, that scopes
+		// the pod reads to a node, only authorizes the kubelets. No get is granted as the daemon reads the pods from
+		// its informer only.
+		{
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			Verbs:     []string{LIST, WATCH},
+		},
+		{
+			APIGroups: []string{"authentication.k8s.io"},
+			Resources: []string{"tokenreviews"},
+			Verbs:     []string{CREATE},
+		},
+		{
+			APIGroups: []string{"authorization.k8s.io"},
+			Resources: []string{"subjectaccessreviews"},
+			Verbs:     []string{CREATE},
+		},
+	}
+	if monitor != nil && monitor.StorageBackend == plugins.StorageBackendEvent {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{""},
+			Resources: []string{"events"},
+			Verbs:     []string{CREATE, PATCH},
+		})
+	}
+	return buildClusterRole(utils.EnoexecDaemonSet, rules)
 }
 
 func buildRoleENoExecEventDaemonSet() *rbacv1.Role {
@@ -107,7 +191,10 @@ func buildRoleENoExecEventDaemonSet() *rbacv1.Role {
 }
 
 // buildDaemonSet returns the DaemonSet object for ENoExecEvent
-func buildDaemonSetENoExecEvent(serviceAccount string, name string, logVerbosity int, args ...string) *appsv1.DaemonSet {
+func buildDaemonSetENoExecEvent(serviceAccount string, name string, logVerbosity int,
+	monitor *plugins.ExecFormatErrorMonitor, args ...string) *appsv1.DaemonSet {
+	criVolumes, criVolumeMounts, daemonArgs := buildCRISocketVolumes(monitor)
+	daemonArgs = append(daemonArgs, buildENoExecEventDaemonArgs(monitor)...)
 	return &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      utils.EnoexecDaemonSet,
@@ -125,7 +212,9 @@ func buildDaemonSetENoExecEvent(serviceAccount string, name string, logVerbosity
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{
-						"app": utils.EnoexecDaemonSet,
+						"app":                   utils.EnoexecDaemonSet,
+						utils.OperandLabelKey:   operandName,
+						utils.ControllerNameKey: utils.EnoexecDaemonSet,
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -139,10 +228,19 @@ func buildDaemonSetENoExecEvent(serviceAccount string, name string, logVerbosity
 							ImagePullPolicy: corev1.PullIfNotPresent,
 
 							Args: args,
-							Command: []string{
+							Command: append([]string{
 								"/enoexec-daemon",
 								fmt.Sprintf("--initial-log-level=%d",
 									logVerbosity),
+								fmt.Sprintf("--metrics-bind-address=:%d", enoexecDaemonMetricsPort),
+								"--cert-dir=/var/run/manager/tls",
+							}, daemonArgs...),
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          "metrics",
+									ContainerPort: enoexecDaemonMetricsPort,
+									Protocol:      corev1.ProtocolTCP,
+								},
 							},
 							Env: []corev1.EnvVar{
 								{
@@ -170,7 +268,7 @@ func buildDaemonSetENoExecEvent(serviceAccount string, name string, logVerbosity
 								RunAsUser:              utils.NewPtr(int64(0)),
 								ReadOnlyRootFilesystem: utils.NewPtr(true),
 							},
-							VolumeMounts: []corev1.VolumeMount{
+							VolumeMounts: append([]corev1.VolumeMount{
 								{
 									Name:      "debugfs",
 									MountPath: "/sys/kernel/debug",
@@ -182,14 +280,14 @@ func buildDaemonSetENoExecEvent(serviceAccount string, name string, logVerbosity
 									ReadOnly:  true,
 								},
 								{
-									Name:      "crio",
-									MountPath: "/var/run/crio/crio.sock",
+									Name:      "metrics-cert",
+									MountPath: "/var/run/manager/tls",
 									ReadOnly:  true,
 								},
-							},
+							}, criVolumeMounts...),
 						},
 					},
-					Volumes: []corev1.Volume{
+					Volumes: append([]corev1.Volume{
 						{
 							Name: "debugfs",
 							VolumeSource: corev1.VolumeSource{
@@ -209,26 +307,133 @@ func buildDaemonSetENoExecEvent(serviceAccount string, name string, logVerbosity
 							},
 						},
 						{
-							Name: "crio",
+							Name: "metrics-cert",
 							VolumeSource: corev1.VolumeSource{
-								HostPath: &corev1.HostPathVolumeSource{
-									Path: "/var/run/crio/crio.sock",
-									Type: utils.NewPtr(corev1.HostPathSocket),
+								Secret: &corev1.SecretVolumeSource{
+									SecretName:  utils.EnoexecDaemonSet,
+									DefaultMode: utils.NewPtr(int32(420)),
 								},
 							},
 						},
-					},
+					}, criVolumes...),
+				},
+			},
+		},
+	}
+}
+
+// buildServiceENoExecEventDaemon returns the Service exposing the metrics endpoint of the ENoExecEvent daemon pods.
+// The serving certificate of the endpoint is generated by the service CA operator in the secret named after the
+// Service.
+func buildServiceENoExecEventDaemon() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utils.EnoexecDaemonSet,
+			Namespace: utils.Namespace(),
+			Labels: map[string]string{
+				utils.OperandLabelKey:   operandName,
+				utils.ControllerNameKey: utils.EnoexecDaemonSet,
+			},
+			Annotations: map[string]string{
+				"service.beta.openshift.io/serving-cert-secret-name": utils.EnoexecDaemonSet,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "metrics",
+					Port:       enoexecDaemonMetricsPort,
+					TargetPort: intstr.FromInt32(enoexecDaemonMetricsPort),
+					Protocol:   corev1.ProtocolTCP,
 				},
 			},
+			Selector: map[string]string{
+				utils.OperandLabelKey:   operandName,
+				utils.ControllerNameKey: utils.EnoexecDaemonSet,
+			},
 		},
 	}
 }
 
-// buildEnoexecDeployment returns a minimal Deployment object matching your YAML
-func buildDeploymentENoExecEventHandler(logVerbosity int) *appsv1.Deployment {
-	d := buildDeployment(logVerbosity, utils.EnoexecControllerName, 2, utils.EnoexecControllerName, "",
-		"--leader-elect", "--enable-enoexec-event-controllers",
-	)
+// buildENoExecEventDaemonArgs returns the ENoExecEvent daemon arguments of the fields set in the ExecFormatErrorMonitor
+// plugin. The daemon defaults apply to the fields that are not set.
+func buildENoExecEventDaemonArgs(monitor *plugins.ExecFormatErrorMonitor) []string {
+	args := make([]string, 0)
+	if monitor == nil {
+		return args
+	}
+	if monitor.ReportHostProcesses {
+		args = append(args, "--report-host-processes")
+	}
+	if monitor.MaxEvents > 0 {
+		args = append(args, fmt.Sprintf("--max-events=%d", monitor.MaxEvents))
+	}
+	if monitor.RateLimit > 0 {
+		args = append(args, fmt.Sprintf("--rate-limit=%d", monitor.RateLimit))
+	}
+	if monitor.Burst > 0 {
+		args = append(args, fmt.Sprintf("--burst=%d", monitor.Burst))
+	}
+	if monitor.TimeoutSeconds > 0 {
+		args = append(args, fmt.Sprintf("--timeout=%ds", monitor.TimeoutSeconds))
+	}
+	if monitor.StorageBackend != "" {
+		args = append(args, fmt.Sprintf("--storage-backend=%s", monitor.StorageBackend))
+	}
+	return args
+}
+
+// buildCRISocketVolumes returns the volumes, the volume mounts and the daemon arguments giving the ENoExecEvent daemon
+// access to the unix socket of the CRI runtime. The socket configured in the ExecFormatErrorMonitor plugin is mounted
+// at a fixed path. Otherwise, the directories of the sockets of the supported runtimes are mounted, read-only, for the
+// daemon to autodetect the socket. The directories missing on a node are created empty.
+func buildCRISocketVolumes(monitor *plugins.ExecFormatErrorMonitor) ([]corev1.Volume, []corev1.VolumeMount, []string) {
+	if monitor != nil && monitor.CRISocket != "" {
+		volume := corev1.Volume{
+			Name: "cri-socket",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: monitor.CRISocket,
+					Type: utils.NewPtr(corev1.HostPathSocket),
+				},
+			},
+		}
+		volumeMount := corev1.VolumeMount{
+			Name:      "cri-socket",
+			MountPath: criSocketMountPath,
+			ReadOnly:  true,
+		}
+		return []corev1.Volume{volume}, []corev1.VolumeMount{volumeMount}, []string{"--cri-socket=" + criSocketMountPath}
+	}
+	volumes := make([]corev1.Volume, 0, len(criSocketHostDirs))
+	volumeMounts := make([]corev1.VolumeMount, 0, len(criSocketHostDirs))
+	for _, dir := range criSocketHostDirs {
+		volumes = append(volumes, corev1.Volume{
+			Name: dir.name,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: "/run/" + dir.path,
+					Type: utils.NewPtr(corev1.HostPathDirectoryOrCreate),
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      dir.name,
+			MountPath: hostRunMountPath + "/" + dir.path,
+			ReadOnly:  true,
+		})
+	}
+	return volumes, volumeMounts, nil
+}
+
+// buildEnoexecDeployment returns a minimal Deployment object matching your YAML.
+// The handler keeps the reconciled ENoExecEvents when the ExecFormatErrorMonitor plugin has a retention policy.
+func buildDeploymentENoExecEventHandler(logVerbosity int, monitor *plugins.ExecFormatErrorMonitor) *appsv1.Deployment {
+	args := []string{"--leader-elect", "--enable-enoexec-event-controllers"}
+	if monitor.RetainsENoExecEvents() {
+		args = append(args, "--retain-enoexec-events")
+	}
+	d := buildDeployment(logVerbosity, utils.EnoexecControllerName, 2, utils.EnoexecControllerName, "", args...)
 	additionalVolumes := []corev1.Volume{
 		{
 			Name: "metrics-cert",
@@ -254,7 +459,42 @@ func buildDeploymentENoExecEventHandler(logVerbosity int) *appsv1.Deployment {
 	return d
 }
 
-func buildExecFormatErrorAvailabilityAlertRule() *monitoringv1.PrometheusRule {
+// buildExecFormatErrorAvailabilityAlertRule returns the alerting rules of the availability of the ENoExecEvent daemon
+// and, when the ENoExecEvents are used, of the ENoExecEvent handler.
+func buildExecFormatErrorAvailabilityAlertRule(monitor *plugins.ExecFormatErrorMonitor) *monitoringv1.PrometheusRule {
+	rules := []monitoringv1.Rule{
+		{
+			Alert: "ExecFormatErrorDaemonDown",
+			Expr:  intstr.FromString(fmt.Sprintf("kube_daemonset_status_number_unavailable{namespace=\"%s\", daemonset=\"%s\"} > 0", utils.Namespace(), utils.EnoexecDaemonSet)),
+			For:   utils.NewPtr[monitoringv1.Duration]("20m"),
+			Annotations: map[string]string{
+				"summary": "The exec format error daemon is not available in all the nodes.",
+				"description": "Some nodes that should be running the exec format error daemon have none of the daemonset pod running and available for more than 20 minutes. " +
+					"Exec Format Errors will not be detected in those nodes",
+				"runbook_url": "https://github.com/openshift/multiarch-tuning-operator/blob/main/docs/alerts/enoexec-event-daemon-down.md",
+			},
+			Labels: map[string]string{
+				"severity": "warning",
+			},
+		},
+	}
+	if monitor.UsesENoExecEvents() {
+		rules = append([]monitoringv1.Rule{
+			{
+				Alert: "ExecFormatErrorHandlerDown",
+				Expr:  intstr.FromString(fmt.Sprintf("kube_deployment_status_replicas_available{namespace=\"%s\", deployment=\"%s\"} == 0", utils.Namespace(), utils.EnoexecControllerName)),
+				For:   utils.NewPtr[monitoringv1.Duration]("1m"),
+				Annotations: map[string]string{
+					"summary":     "The exec format error handler should have at least 1 replica running and ready.",
+					"description": "The exec format error handler has been down for more than 1 minute. ",
+					"runbook_url": "https://github.com/outrigger-project/multiarch-tuning-operator/blob/main/docs/alerts/enoexec-event-handler-down.md",
+				},
+				Labels: map[string]string{
+					"severity": "critical",
+				},
+			},
+		}, rules...)
+	}
 	return &monitoringv1.PrometheusRule{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: monitoringv1.SchemeGroupVersion.String(),
@@ -267,43 +507,21 @@ func buildExecFormatErrorAvailabilityAlertRule() *monitoringv1.PrometheusRule {
 		Spec: monitoringv1.PrometheusRuleSpec{
 			Groups: []monitoringv1.RuleGroup{
 				{
-					Name: "multiarch-tuning-operator-enoexec.rules",
-					Rules: []monitoringv1.Rule{
-						{
-							Alert: "ExecFormatErrorHandlerDown",
-							Expr:  intstr.FromString(fmt.Sprintf("kube_deployment_status_replicas_available{namespace=\"%s\", deployment=\"%s\"} == 0", utils.Namespace(), utils.EnoexecControllerName)),
-							For:   utils.NewPtr[monitoringv1.Duration]("1m"),
-							Annotations: map[string]string{
-								"summary":     "The exec format error handler should have at least 1 replica running and ready.",
-								"description": "The exec format error handler has been down for more than 1 minute. ",
-								"runbook_url": "https://github.com/outrigger-project/multiarch-tuning-operator/blob/main/docs/alerts/enoexec-event-handler-down.md",
-							},
-							Labels: map[string]string{
-								"severity": "critical",
-							},
-						},
-						{
-							Alert: "ExecFormatErrorDaemonDown",
-							Expr:  intstr.FromString(fmt.Sprintf("kube_daemonset_status_number_unavailable{namespace=\"%s\", daemonset=\"%s\"} > 0", utils.Namespace(), utils.EnoexecDaemonSet)),
-							For:   utils.NewPtr[monitoringv1.Duration]("20m"),
-							Annotations: map[string]string{
-								"summary": "The exec format error daemon is not available in all the nodes.",
-								"description": "Some nodes that should be running the exec format error daemon have none of the daemonset pod running and available for more than 20 minutes. " +
-									"Exec Format Errors will not be detected in those nodes",
-								"runbook_url": "https://github.com/openshift/multiarch-tuning-operator/blob/main/docs/alerts/enoexec-event-daemon-down.md",
-							},
-							Labels: map[string]string{
-								"severity": "warning",
-							},
-						},
-					},
+					Name:  "multiarch-tuning-operator-enoexec.rules",
+					Rules: rules,
 				},
 			},
 		},
 	}
 }
 
-func buildExecFormatErrorsDetectedAlertRule() *monitoringv1.PrometheusRule {
+// buildExecFormatErrorsDetectedAlertRule returns the alerting rule of the exec format errors detected in the pods. The
+// errors are counted by the ENoExecEvent handler when the ENoExecEvents are used, by the daemons otherwise.
+func buildExecFormatErrorsDetectedAlertRule(monitor *plugins.ExecFormatErrorMonitor) *monitoringv1.PrometheusRule {
+	expr := "rate(mto_enoexecevents_total[6h]) > 0"
+	if !monitor.UsesENoExecEvents() {
+		expr = "sum(rate(mto_enoexec_daemon_resolved_events_total{scope=\"pod\"}[6h])) > 0"
+	}
 	return &monitoringv1.PrometheusRule{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: monitoringv1.SchemeGroupVersion.String(),
@@ -320,7 +538,7 @@ func buildExecFormatErrorsDetectedAlertRule() *monitoringv1.PrometheusRule {
 					Rules: []monitoringv1.Rule{
 						{
 							Alert: utils.ExecFormatErrorsDetected,
-							Expr:  intstr.FromString("rate(mto_enoexecevents_total[6h]) > 0"),
+							Expr:  intstr.FromString(expr),
 							For:   utils.NewPtr[monitoringv1.Duration]("1m"),
 							Annotations: map[string]string{
 								"summary":     "Exec Format Errors detected in the past 6 hours.",
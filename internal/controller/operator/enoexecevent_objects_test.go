@@ -0,0 +1,81 @@
+package operator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+)
+
+func TestBuildCRISocketVolumes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	volumes, volumeMounts, args := buildCRISocketVolumes(&plugins.ExecFormatErrorMonitor{CRISocket: "/run/cri-dockerd.sock"})
+	g.Expect(volumes).To(HaveLen(1))
+	g.Expect(volumes[0].HostPath.Path).To(Equal("/run/cri-dockerd.sock"))
+	g.Expect(*volumes[0].HostPath.Type).To(Equal(corev1.HostPathSocket))
+	g.Expect(volumeMounts).To(ConsistOf(HaveField("MountPath", criSocketMountPath)))
+	g.Expect(args).To(Equal([]string{"--cri-socket=" + criSocketMountPath}))
+
+	volumes, volumeMounts, args = buildCRISocketVolumes(nil)
+	hostPaths := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		hostPaths = append(hostPaths, volume.HostPath.Path)
+	}
+	g.Expect(hostPaths).To(ConsistOf("/run/crio", "/run/containerd", "/run/k3s/containerd"),
+		"only the directories of the runtime sockets should be mounted")
+	mountPaths := make([]string, 0, len(volumeMounts))
+	for _, volumeMount := range volumeMounts {
+		g.Expect(volumeMount.ReadOnly).To(BeTrue())
+		mountPaths = append(mountPaths, volumeMount.MountPath)
+	}
+	g.Expect(mountPaths).To(ConsistOf(hostRunMountPath+"/crio", hostRunMountPath+"/containerd",
+		hostRunMountPath+"/k3s/containerd"))
+	g.Expect(args).To(BeEmpty())
+}
+
+func TestBuildENoExecEventDaemonArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		monitor *plugins.ExecFormatErrorMonitor
+		want    []string
+	}{
+		{
+			name:    "no plugin",
+			monitor: nil,
+			want:    []string{},
+		},
+		{
+			name:    "daemon defaults",
+			monitor: &plugins.ExecFormatErrorMonitor{},
+			want:    []string{},
+		},
+		{
+			name: "all the fields set",
+			monitor: &plugins.ExecFormatErrorMonitor{
+				ReportHostProcesses: true,
+				MaxEvents:           2048,
+				RateLimit:           20,
+				Burst:               40,
+				TimeoutSeconds:      10,
+				StorageBackend:      plugins.StorageBackendEvent,
+			},
+			want: []string{"--report-host-processes", "--max-events=2048", "--rate-limit=20", "--burst=40",
+				"--timeout=10s", "--storage-backend=Event"},
+		},
+		{
+			name:    "storage backend only",
+			monitor: &plugins.ExecFormatErrorMonitor{StorageBackend: plugins.StorageBackendLog},
+			want:    []string{"--storage-backend=Log"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(buildENoExecEventDaemonArgs(tt.monitor)).To(Equal(tt.want))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package operator
 
 import (
 	"fmt"
+	"strings"
 
 	admissionv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -9,6 +10,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
@@ -39,10 +41,19 @@ func buildMutatingWebhookConfiguration(clusterPodPlacementConfig *v1beta1.Cluste
 						Path:      utils.NewPtr("/add-pod-scheduling-gate"),
 					},
 				},
-				NamespaceSelector: clusterPodPlacementConfig.Spec.NamespaceSelector,
-				FailurePolicy:     utils.NewPtr(admissionv1.Ignore),
-				SideEffects:       utils.NewPtr(admissionv1.SideEffectClassNone),
-				Name:              utils.PodMutatingWebhookName,
+				NamespaceSelector: buildWebhookNamespaceSelector(clusterPodPlacementConfig),
+				// The pods that opted out with the exclude-pod-placement label never reach the webhook.
+				ObjectSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      utils.PodOptOutLabel,
+							Operator: metav1.LabelSelectorOpDoesNotExist,
+						},
+					},
+				},
+				FailurePolicy: utils.NewPtr(admissionv1.Ignore),
+				SideEffects:   utils.NewPtr(admissionv1.SideEffectClassNone),
+				Name:          utils.PodMutatingWebhookName,
 				Rules: []admissionv1.RuleWithOperations{
 					{
 						Operations: []admissionv1.OperationType{
@@ -60,6 +71,42 @@ func buildMutatingWebhookConfiguration(clusterPodPlacementConfig *v1beta1.Cluste
 	}
 }
 
+// buildWebhookNamespaceSelector returns the namespace selector of the enrolled namespaces, excluding the ignored ones
+// by their kubernetes.io/metadata.name label. The label selectors do not support glob patterns: only the
+// ignoredNamespaces entries that are plain names are excluded by the selector, so that it does not depend on the
+// namespaces existing at reconcile time. The pods of the namespaces matching a glob pattern still reach the webhook,
+// which ignores them without gating.
+func buildWebhookNamespaceSelector(clusterPodPlacementConfig *v1beta1.ClusterPodPlacementConfig) *metav1.LabelSelector {
+	selector := clusterPodPlacementConfig.EnrolledNamespaceSelector()
+	ignoredNamespaces := ignoredNamespaceNames(clusterPodPlacementConfig)
+	if len(ignoredNamespaces) == 0 {
+		return selector
+	}
+	if selector == nil {
+		selector = &metav1.LabelSelector{}
+	} else {
+		selector = selector.DeepCopy()
+	}
+	selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      corev1.LabelMetadataName,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   ignoredNamespaces,
+	})
+	return selector
+}
+
+// ignoredNamespaceNames returns the sorted ignoredNamespaces entries of the ClusterPodPlacementConfig that are not glob
+// patterns.
+func ignoredNamespaceNames(clusterPodPlacementConfig *v1beta1.ClusterPodPlacementConfig) []string {
+	names := sets.New[string]()
+	for _, pattern := range clusterPodPlacementConfig.Spec.IgnoredNamespaces {
+		if !strings.ContainsAny(pattern, `*?[\`) {
+			names.Insert(pattern)
+		}
+	}
+	return sets.List(names)
+}
+
 // buildWebhookDeployment creates the specific deployment for the pod-placement-webhook.
 func buildWebhookDeployment(clusterPodPlacementConfig *v1beta1.ClusterPodPlacementConfig) *appsv1.Deployment {
 	d := buildDeployment(clusterPodPlacementConfig.Spec.LogVerbosity.ToZapLevelInt(), utils.PodPlacementWebhookName, 3, utils.PodPlacementWebhookName, "",
@@ -252,6 +299,34 @@ func buildClusterRoleController() *rbacv1.ClusterRole {
 			Resources: []string{v1beta1.PodPlacementConfigResource},
 			Verbs:     []string{LIST, WATCH, GET},
 		},
+		{
+			APIGroups: []string{v1beta1.GroupVersion.Group},
+			Resources: []string{v1beta1.PodPlacementConfigResource + "/status"},
+			Verbs:     []string{UPDATE},
+		},
+		{
+			APIGroups: []string{v1beta1.GroupVersion.Group},
+			Resources: []string{v1beta1.ArchitectureMismatchReportResource},
+			Verbs:     []string{GET, CREATE, UPDATE},
+		},
+		{
+			APIGroups: []string{v1beta1.GroupVersion.Group},
+			Resources: []string{v1beta1.ArchitectureMismatchReportResource + "/status"},
+			Verbs:     []string{UPDATE},
+		},
+		{
+			// The ArchitectureMismatchReport is owned by the ClusterPodPlacementConfig through a controller reference,
+			// which sets blockOwnerDeletion: the OwnerReferencesPermissionEnforcement admission plugin requires the
+			// update of the owner's finalizers to create it.
+			APIGroups: []string{v1beta1.GroupVersion.Group},
+			Resources: []string{v1beta1.ClusterPodPlacementConfigResource + "/finalizers"},
+			Verbs:     []string{UPDATE},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"nodes"},
+			Verbs:     []string{LIST, WATCH, GET},
+		},
 		{
 			APIGroups: []string{""},
 			Resources: []string{"configmaps"},
@@ -0,0 +1,51 @@
+package operator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+)
+
+func TestBuildClusterRoleControllerGrantsOwnerFinalizers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// The ArchitectureMismatchReport is created with a controller reference to the ClusterPodPlacementConfig:
+	// without the update of its finalizers, OwnerReferencesPermissionEnforcement rejects the creation.
+	clusterRole := buildClusterRoleController()
+	g.Expect(clusterRole.Rules).To(ContainElement(rbacv1.PolicyRule{
+		APIGroups: []string{v1beta1.GroupVersion.Group},
+		Resources: []string{v1beta1.ClusterPodPlacementConfigResource + "/finalizers"},
+		Verbs:     []string{UPDATE},
+	}))
+	g.Expect(clusterRole.Rules).To(ContainElement(rbacv1.PolicyRule{
+		APIGroups: []string{v1beta1.GroupVersion.Group},
+		Resources: []string{v1beta1.ArchitectureMismatchReportResource},
+		Verbs:     []string{GET, CREATE, UPDATE},
+	}))
+}
+
+func TestBuildWebhookNamespaceSelectorExcludesOnlyNames(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	clusterPodPlacementConfig := &v1beta1.ClusterPodPlacementConfig{}
+	g.Expect(buildWebhookNamespaceSelector(clusterPodPlacementConfig)).To(BeNil())
+
+	// The glob patterns are matched by the webhook: the selector must not depend on the namespaces existing at
+	// reconcile time.
+	clusterPodPlacementConfig.Spec.IgnoredNamespaces = []string{"team-b", "openshift-*", "team-a", "*-system", "ci-?"}
+	selector := buildWebhookNamespaceSelector(clusterPodPlacementConfig)
+	g.Expect(selector.MatchExpressions).To(ConsistOf(metav1.LabelSelectorRequirement{
+		Key:      corev1.LabelMetadataName,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{"team-a", "team-b"},
+	}))
+
+	clusterPodPlacementConfig.Spec.IgnoredNamespaces = []string{"openshift-*"}
+	g.Expect(buildWebhookNamespaceSelector(clusterPodPlacementConfig)).To(BeNil())
+}
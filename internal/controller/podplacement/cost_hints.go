@@ -0,0 +1,132 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podplacement
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// architecturesCostHints stores the cost of each architecture, as last read by the NodeCapacitySyncer from the node
+// labels and from the cost hints ConfigMap. It is empty until the first refresh or when no configuration enables the
+// CostAwareScoring plugin.
+var architecturesCostHints = &costHintsStore{}
+
+type costHintsStore struct {
+	mu         sync.RWMutex
+	nodeLabels map[string]float64
+	configMap  map[string]float64
+}
+
+func (s *costHintsStore) store(nodeLabels, configMap map[string]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodeLabels = nodeLabels
+	s.configMap = configMap
+}
+
+func (s *costHintsStore) load(source plugins.CostHintsSource) map[string]float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if source == plugins.CostHintsSourceConfigMap {
+		return s.configMap
+	}
+	return s.nodeLabels
+}
+
+// nodeLabelsCostHints returns the cost of each architecture as the average of the values of the
+// multiarch.openshift.io/architecture-cost label of its nodes. The invalid values are ignored.
+func (s *NodeCapacitySyncer) nodeLabelsCostHints() map[string]float64 {
+	var nodes []*corev1.Node
+	for _, obj := range s.nodeLister.List() {
+		if node, ok := obj.(*corev1.Node); ok {
+			nodes = append(nodes, node)
+		}
+	}
+	return averageNodesCost(nodes)
+}
+
+func averageNodesCost(nodes []*corev1.Node) map[string]float64 {
+	sums := map[string]float64{}
+	counts := map[string]int{}
+	for _, node := range nodes {
+		value, ok := node.Labels[utils.ArchitectureCostLabel]
+		if !ok {
+			continue
+		}
+		cost, err := strconv.ParseFloat(value, 64)
+		if err != nil || cost <= 0 {
+			continue
+		}
+		sums[node.Labels[utils.ArchLabel]] += cost
+		counts[node.Labels[utils.ArchLabel]]++
+	}
+	costs := map[string]float64{}
+	for architecture, sum := range sums {
+		costs[architecture] = sum / float64(counts[architecture])
+	}
+	return costs
+}
+
+// configMapCostHints returns the cost of each architecture read from the cost hints ConfigMap in the namespace of the
+// operator. Each key of the ConfigMap is an architecture and its value is the cost. The invalid values are ignored.
+// It returns an empty map if the ConfigMap does not exist.
+func (s *NodeCapacitySyncer) configMapCostHints(ctx context.Context) (map[string]float64, error) {
+	configMap := &corev1.ConfigMap{}
+	// The cost hints ConfigMap is read from the API server to avoid caching all the ConfigMaps of the cluster.
+	if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: utils.Namespace(),
+		Name: utils.ArchitectureCostHintsConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]float64{}, nil
+		}
+		return nil, err
+	}
+	return parseCostHints(ctx, configMap.Data), nil
+}
+
+func parseCostHints(ctx context.Context, data map[string]string) map[string]float64 {
+	log := ctrllog.FromContext(ctx)
+	costs := map[string]float64{}
+	for architecture, value := range data {
+		cost, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || cost <= 0 {
+			log.Info("Ignoring the invalid cost hint", "architecture", architecture, "value", value)
+			continue
+		}
+		costs[architecture] = cost
+	}
+	return costs
+}
+
+// costAwareNodeAffinityScoring returns the NodeAffinityScoring plugin whose platforms are computed by the given
+// CostAwareScoring plugin from the cost hints of its source. The platforms are empty if no cost hint is known yet.
+func costAwareNodeAffinityScoring(costAwareScoring *plugins.CostAwareScoring) *plugins.NodeAffinityScoring {
+	return &plugins.NodeAffinityScoring{
+		BasePlugin: plugins.BasePlugin{Enabled: true},
+		Platforms:  costAwareScoring.PlatformTerms(architecturesCostHints.load(costAwareScoring.Source)),
+	}
+}
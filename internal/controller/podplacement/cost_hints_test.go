@@ -0,0 +1,128 @@
+package podplacement
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+
+	. "github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
+)
+
+func Test_averageNodesCost(t *testing.T) {
+	nodes := []*v1.Node{
+		NewNodeBuilder().WithName("amd64-1").WithLabel(utils.ArchLabel, utils.ArchitectureAmd64).
+			WithLabel(utils.ArchitectureCostLabel, "1.0").Build(),
+		NewNodeBuilder().WithName("amd64-2").WithLabel(utils.ArchLabel, utils.ArchitectureAmd64).
+			WithLabel(utils.ArchitectureCostLabel, "2.0").Build(),
+		NewNodeBuilder().WithName("arm64-1").WithLabel(utils.ArchLabel, utils.ArchitectureArm64).
+			WithLabel(utils.ArchitectureCostLabel, "0.75").Build(),
+		NewNodeBuilder().WithName("arm64-2").WithLabel(utils.ArchLabel, utils.ArchitectureArm64).
+			WithLabel(utils.ArchitectureCostLabel, "invalid").Build(),
+		NewNodeBuilder().WithName("s390x-1").WithLabel(utils.ArchLabel, utils.ArchitectureS390x).Build(),
+	}
+	want := map[string]float64{
+		utils.ArchitectureAmd64: 1.5,
+		utils.ArchitectureArm64: 0.75,
+	}
+	if got := averageNodesCost(nodes); !reflect.DeepEqual(got, want) {
+		t.Errorf("averageNodesCost() = %v, want %v", got, want)
+	}
+}
+
+func Test_parseCostHints(t *testing.T) {
+	data := map[string]string{
+		utils.ArchitectureAmd64:   "1.2",
+		utils.ArchitectureArm64:   " 0.8\n",
+		utils.ArchitecturePpc64le: "-1",
+		utils.ArchitectureS390x:   "expensive",
+	}
+	want := map[string]float64{
+		utils.ArchitectureAmd64: 1.2,
+		utils.ArchitectureArm64: 0.8,
+	}
+	if got := parseCostHints(ctx, data); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseCostHints() = %v, want %v", got, want)
+	}
+}
+
+func Test_nodeAffinityScoringSources_costAwareScoring(t *testing.T) {
+	g := NewGomegaWithT(t)
+	architecturesCostHints.store(nil, map[string]float64{
+		utils.ArchitectureAmd64: 1.0,
+		utils.ArchitectureArm64: 0.5,
+	})
+	defer architecturesCostHints.store(nil, nil)
+
+	ppc := NewPodPlacementConfig().
+		WithName("test-cost-aware").
+		WithPriority(10).
+		WithCostAwareScoring(true, plugins.CostHintsSourceConfigMap, 60).Build()
+	cppc := NewClusterPodPlacementConfig().
+		WithName(common.SingletonResourceObjectName).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 20).
+		WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 60).Build()
+
+	sources, _ := nodeAffinityScoringSources(cppc, []v1beta1.PodPlacementConfig{*ppc})
+	g.Expect(sources).To(HaveLen(2), "each configuration should be a single source")
+	g.Expect(sources[0].name).To(Equal(ppc.AffinitySource()))
+	g.Expect(sources[0].nodeAffinityScoring.Platforms).To(Equal([]plugins.NodeAffinityScoringPlatformTerm{
+		{Architecture: utils.ArchitectureAmd64, Weight: 30},
+		{Architecture: utils.ArchitectureArm64, Weight: 60},
+	}))
+	g.Expect(sources[1].name).To(Equal(v1beta1.ClusterPodPlacementConfigKind))
+	g.Expect(sources[1].nodeAffinityScoring.Platforms).To(Equal(cppc.Spec.Plugins.NodeAffinityScoring.Platforms),
+		"no cost hint is known from the node labels")
+
+	pod := newPod(NewPod().Build(), ctx, nil)
+	pod.setPreferredArchNodeAffinityFromSources(sources, common.MergeStrategyFirstMatchWins)
+	g.Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(HaveLen(2))
+	g.Expect(pod.Annotations[utils.PreferredNodeAffinitySourcesAnnotation]).To(ContainSubstring(
+		utils.ArchitectureArm64 + ":60:" + ppc.AffinitySource()))
+}
+
+func Test_setPreferredArchNodeAffinityFromSources_overrideWithBothPlugins(t *testing.T) {
+	g := NewGomegaWithT(t)
+	architecturesCostHints.store(nil, map[string]float64{
+		utils.ArchitectureAmd64: 1.0,
+		utils.ArchitectureArm64: 0.5,
+	})
+	defer architecturesCostHints.store(nil, nil)
+
+	ppc := NewPodPlacementConfig().
+		WithName("test-override").
+		WithPriority(10).
+		WithMergeStrategy(common.MergeStrategyOverride).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 80).
+		WithCostAwareScoring(true, plugins.CostHintsSourceConfigMap, 60).Build()
+	cppc := NewClusterPodPlacementConfig().
+		WithName(common.SingletonResourceObjectName).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureS390x, 20).Build()
+
+	sources, strategy := nodeAffinityScoringSources(cppc, []v1beta1.PodPlacementConfig{*ppc})
+	pod := newPod(NewPod().Build(), ctx, nil)
+	pod.setPreferredArchNodeAffinityFromSources(sources, strategy)
+	g.Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(Equal(
+		NewPod().WithPreferredDuringSchedulingIgnoredDuringExecution(
+			NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureAmd64).WithWeight(80).Build(),
+			NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureArm64).WithWeight(60).Build(),
+		).Build().Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution),
+		"the CostAwareScoring terms of the overriding PodPlacementConfig should be applied")
+	g.Expect(strings.Split(pod.Annotations[utils.PreferredNodeAffinitySourcesAnnotation], ",")).To(ConsistOf(
+		"amd64:80:"+ppc.AffinitySource(),
+		"amd64:30:"+ppc.AffinitySource()+":skipped",
+		"arm64:60:"+ppc.AffinitySource(),
+		"s390x:20:ClusterPodPlacementConfig:skipped",
+	))
+}
@@ -13,25 +13,47 @@ const (
 	NoSupportedArchitecturesFound                 = "NoSupportedArchitecturesFound"
 	ArchitecturePreferredAffinityDuplicates       = "ArchAwarePreferredAffinityDuplicates"
 	ArchitectureAwareFallbackNodeAffinitySet      = "ArchAwareFallbackPredicateSet"
+	ArchitectureAwareAudited                      = "ArchAwareAudited"
+	ArchitectureAwareAuditFailure                 = "ArchAwareAuditFailed"
+	ArchitectureMismatch                          = "ArchAwareArchitectureMismatch"
+	NoCompatibleNodes                             = "ArchAwareNoCompatibleNodes"
+	UnsupportedUserArchitectures                  = "ArchAwareUnsupportedUserArchitectures"
+	ArchitectureAwareSchedulingGateTimedOut       = "ArchAwareSchedGateTimedOut"
+	ArchitectureAwarePodOptedOut                  = "ArchAwarePodOptedOut"
 
-	SchedulingGateAddedMsg            = "Successfully gated with the " + utils.SchedulingGateName + " scheduling gate"
-	SchedulingGateRemovalSuccessMsg   = "Successfully removed the " + utils.SchedulingGateName + " scheduling gate"
-	SchedulingGateRemovalFailureMsg   = "Failed to remove the scheduling gate \"" + utils.SchedulingGateName + "\""
+	SchedulingGateAddedMsg          = "Successfully gated with the " + utils.SchedulingGateName + " scheduling gate"
+	SchedulingGateRemovalSuccessMsg = "Successfully removed the " + utils.SchedulingGateName + " scheduling gate"
+	SchedulingGateRemovalFailureMsg = "Failed to remove the scheduling gate \"" + utils.SchedulingGateName + "\""
+	SchedulingGateTimedOutMsg       = "Removed the " + utils.SchedulingGateName + " scheduling gate after the %s timeout; " +
+		"the nodeAffinity was not set for the architectures of the container images"
 	ArchitecturePredicatesConflictMsg = "All the scheduling predicates already include architecture-specific constraints"
 	ArchitecturePredicateSetupMsg     = "Set the supported architectures to "
+	PodOptedOutMsg                    = "The pod opted out of architecture-aware scheduling with the " + utils.PodOptOutLabel +
+		" label or annotation; its nodeAffinity is not modified"
 
 	ArchitecturePreferredPredicateSetupMsg         = "Applied all architecture preferences from configuration"
 	ArchitecturePreferredAffinityWithDuplicatesMsg = "Applied some architecture preferences from configuration; others were already set"
+	ArchitecturePreferredAffinityMergedMsg         = "Applied the merged architecture preferences from configurations"
 	ArchitecturePreferredAffinityAllDuplicatesMsg  = "Skipped all architecture preferences from configuration; all were already set"
 	ArchitecturePreferredPredicateSkippedMsg       = "Skipped configuration; no architecture preferences were provided"
 
 	ImageArchitectureInspectionErrorMsg = "The operator encountered an error while inspecting the container image to determine its supported architectures. " +
 		"This is typically caused by the image registry being unreachable, returning an error, or a misconfiguration. " +
 		"Registry error: "
-	NoSupportedArchitecturesFoundMsg    = "Pod cannot be scheduled due to incompatible image architectures; container images have no supported architectures in common"
-	ArchitectureAwareGatedPodIgnoredMsg = "The gated pod has been modified and is no longer eligible for architecture-aware scheduling"
-	ImageInspectionErrorMaxRetriesMsg   = "The operator was unable to determine the supported architectures after multiple retries. " +
+	NoSupportedArchitecturesFoundMsg      = "Pod cannot be scheduled due to incompatible image architectures; container images have no supported architectures in common"
+	NoSupportedArchitecturesContainersMsg = "; containers not supporting the architecture of most containers: {%s}"
+	ArchitectureAwareGatedPodIgnoredMsg   = "The gated pod has been modified and is no longer eligible for architecture-aware scheduling"
+	ImageInspectionErrorMaxRetriesMsg     = "The operator was unable to determine the supported architectures after multiple retries. " +
 		"This is typically caused by the image registry being unreachable, returning an error, or a misconfiguration in the cluster's pull secrets or network. " +
 		"Registry error"
 	ArchitectureFallbackSetupMsg = "Image inspection failed; setting the nodeAffinity to the fallback architecture: "
+	AuditNodeAffinityMsg         = "Audit mode: the pod was not modified; the nodeAffinity the operator would have set is recorded in the " +
+		utils.AuditNodeAffinityAnnotation + " annotation"
+	AuditFailureMsg         = "Audit mode: the operator was unable to compute the nodeAffinity for the pod: "
+	ArchitectureMismatchMsg = "The pod is running on a node whose architecture is not supported by its container images. " +
+		"Node architecture: %s; supported architectures: {%s}"
+	NoCompatibleNodesMsg = "No ready and schedulable node supports the architectures of the container images {%s}; " +
+		"node architectures: {%s}"
+	UnsupportedUserArchitecturesMsg = "The architecture constraints of the pod allow architectures not supported by its container images {%s}; " +
+		"supported architectures: {%s}"
 )
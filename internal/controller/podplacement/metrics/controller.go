@@ -17,6 +17,9 @@ var (
 	TimeToInspectPodImages  prometheus.Histogram
 	ProcessedPodsCtrl       prometheus.Counter
 	FailedInspectionCounter prometheus.Counter
+	AuditedPods             *prometheus.CounterVec
+	ScannedRunningPods      prometheus.Gauge
+	MismatchedRunningPods   prometheus.Gauge
 )
 
 var onceController sync.Once
@@ -26,7 +29,6 @@ func InitPodPlacementControllerMetrics() {
 }
 
 func initPodPlacementControllerMetrics() {
-	initCommonMetrics()
 	TimeToProcessPod = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "mto_ppo_ctrl_time_to_process_pod_seconds",
@@ -67,6 +69,25 @@ func initPodPlacementControllerMetrics() {
 			Help: "The total number of image inspections that failed",
 		},
 	)
+	AuditedPods = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mto_ppo_ctrl_audited_pods_total",
+			Help: "The total number of pods audited by the pod placement controller in Audit mode, by result",
+		}, []string{"result"},
+	)
+	ScannedRunningPods = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "mto_ppo_ctrl_scanned_running_pods",
+			Help: "The number of running pods inspected in the last scan of the running pods scanner",
+		},
+	)
+	MismatchedRunningPods = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "mto_ppo_ctrl_arch_mismatched_running_pods",
+			Help: "The number of running pods found in the last scan on a node whose architecture is not supported by their images",
+		},
+	)
 	metrics2.Registry.MustRegister(TimeToProcessPod, TimeToProcessGatedPod, TimeToInspectImage,
-		TimeToInspectPodImages, ProcessedPodsCtrl, FailedInspectionCounter)
+		TimeToInspectPodImages, ProcessedPodsCtrl, FailedInspectionCounter, AuditedPods,
+		ScannedRunningPods, MismatchedRunningPods)
 }
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	metrics2 "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// gatedPodsListTimeout bounds the time spent listing the gated pods at each scrape.
+const gatedPodsListTimeout = 10 * time.Second
+
+// gatedPodsAgeBuckets are the upper bounds of the age buckets of the gated pods, with their label values.
+// The pods older than the last bound are counted in the gatedPodsAgeOverflowBucket bucket.
+var gatedPodsAgeBuckets = []struct {
+	bound time.Duration
+	label string
+}{
+	{time.Minute, "0-1m"},
+	{5 * time.Minute, "1m-5m"},
+	{15 * time.Minute, "5m-15m"},
+	{time.Hour, "15m-1h"},
+}
+
+const gatedPodsAgeOverflowBucket = "1h+"
+
+var gatedPodsDesc = prometheus.NewDesc(
+	"mto_ppo_pods_gated",
+	"The current number of pods gated by the pod placement operand, by namespace and age bucket",
+	[]string{"namespace", "age"}, nil,
+)
+
+// GatedPodsCollector is a prometheus.Collector reporting the number of pods labeled with
+// multiarch.openshift.io/scheduling-gate=gated, partitioned by namespace and age bucket. The pods are counted at each
+// scrape from the given reader, expected to be backed by the informer cache of the pod placement controller.
+// Only the leader replica reports the gated pods, so that the series are not duplicated across the replicas.
+type GatedPodsCollector struct {
+	reader client.Reader
+	// elected is closed when the replica is elected leader.
+	elected <-chan struct{}
+}
+
+var onceGatedPodsCollector sync.Once
+
+// RegisterGatedPodsCollector registers a GatedPodsCollector counting the gated pods from the given reader once the
+// elected channel is closed.
+func RegisterGatedPodsCollector(reader client.Reader, elected <-chan struct{}) {
+	onceGatedPodsCollector.Do(func() {
+		metrics2.Registry.MustRegister(&GatedPodsCollector{reader: reader, elected: elected})
+	})
+}
+
+func (c *GatedPodsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- gatedPodsDesc
+}
+
+func (c *GatedPodsCollector) Collect(ch chan<- prometheus.Metric) {
+	select {
+	case <-c.elected:
+	default:
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gatedPodsListTimeout)
+	defer cancel()
+	podList := &corev1.PodList{}
+	if err := c.reader.List(ctx, podList, client.MatchingLabels{
+		utils.SchedulingGateLabel: utils.SchedulingGateLabelValueGated,
+	}); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "Unable to list the gated pods")
+		ch <- prometheus.NewInvalidMetric(gatedPodsDesc, err)
+		return
+	}
+	for key, count := range countGatedPods(podList.Items, time.Now()) {
+		ch <- prometheus.MustNewConstMetric(gatedPodsDesc, prometheus.GaugeValue, float64(count), key.namespace, key.age)
+	}
+}
+
+type gatedPodsKey struct {
+	namespace string
+	age       string
+}
+
+// countGatedPods returns the number of pods by namespace and age bucket, where the age of a pod is computed from its
+// creation, when the webhook gates it.
+func countGatedPods(pods []corev1.Pod, now time.Time) map[gatedPodsKey]int {
+	counts := map[gatedPodsKey]int{}
+	for i := range pods {
+		counts[gatedPodsKey{
+			namespace: pods[i].Namespace,
+			age:       gatedPodsAgeBucket(now.Sub(pods[i].CreationTimestamp.Time)),
+		}]++
+	}
+	return counts
+}
+
+func gatedPodsAgeBucket(age time.Duration) string {
+	for _, bucket := range gatedPodsAgeBuckets {
+		if age < bucket.bound {
+			return bucket.label
+		}
+	}
+	return gatedPodsAgeOverflowBucket
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func Test_countGatedPods(t *testing.T) {
+	now := time.Now()
+	pod := func(namespace string, age time.Duration) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}}
+	}
+	pods := []corev1.Pod{
+		pod("ns1", 10*time.Second),
+		pod("ns1", 30*time.Second),
+		pod("ns1", 3*time.Minute),
+		pod("ns2", 10*time.Minute),
+		pod("ns2", 30*time.Minute),
+		pod("ns2", 2*time.Hour),
+		pod("ns2", time.Hour),
+	}
+	want := map[gatedPodsKey]int{
+		{namespace: "ns1", age: "0-1m"}:   2,
+		{namespace: "ns1", age: "1m-5m"}:  1,
+		{namespace: "ns2", age: "5m-15m"}: 1,
+		{namespace: "ns2", age: "15m-1h"}: 1,
+		{namespace: "ns2", age: "1h+"}:    2,
+	}
+	if got := countGatedPods(pods, now); !reflect.DeepEqual(got, want) {
+		t.Errorf("countGatedPods() = %v, want %v", got, want)
+	}
+}
+
+func TestGatedPodsCollector_CollectNotLeader(t *testing.T) {
+	// The reader is not used until the replica is elected leader.
+	c := &GatedPodsCollector{elected: make(chan struct{})}
+	ch := make(chan prometheus.Metric, 1)
+	c.Collect(ch)
+	close(ch)
+	if n := len(ch); n != 0 {
+		t.Errorf("Collect() reported %d metrics before the election, want 0", n)
+	}
+}
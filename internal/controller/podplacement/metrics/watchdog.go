@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"sync"
+
+	metrics2 "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var SchedulingGateTimeouts prometheus.Counter
+
+var onceWatchdog sync.Once
+
+func InitSchedulingGateWatchdogMetrics() {
+	onceWatchdog.Do(initSchedulingGateWatchdogMetrics)
+}
+
+func initSchedulingGateWatchdogMetrics() {
+	SchedulingGateTimeouts = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "mto_ppo_scheduling_gate_timeouts_total",
+			Help: "The total number of pods whose scheduling gate was removed by the watchdog after the timeout",
+		},
+	)
+	metrics2.Registry.MustRegister(SchedulingGateTimeouts)
+}
@@ -23,7 +23,6 @@ func InitWebhookMetrics() {
 }
 
 func initWebhookMetrics() {
-	initCommonMetrics()
 	ProcessedPodsWH = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: "mto_ppo_wh_pods_processed_total",
@@ -0,0 +1,382 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podplacement
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientv1 "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/informers/clusterpodplacementconfig"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+const (
+	// nodeCapacityRefreshPeriod is the period at which the free capacity of the nodes is recomputed.
+	nodeCapacityRefreshPeriod = 30 * time.Second
+	// nodesResyncPeriod is the resync period of the nodes and pods informers.
+	nodesResyncPeriod = time.Hour
+	// podsByNodeIndex is the name of the index of the pods informer by the name of the node of the pods.
+	podsByNodeIndex = "spec.nodeName"
+)
+
+// freeCapacity is the free allocatable CPU, in millicores, and memory, in bytes, of a set of nodes.
+type freeCapacity struct {
+	cpu    int64
+	memory int64
+}
+
+// architecturesFreeCapacity stores the free capacity of the schedulable nodes of each architecture, as last computed
+// by the NodeCapacitySyncer. It is nil until the first refresh or when no configuration uses the Dynamic mode.
+var architecturesFreeCapacity = &freeCapacityStore{}
+
+// schedulableArchitectures stores the architectures of the ready and schedulable nodes, as last computed by the
+// NodeCapacitySyncer. It is nil until the first refresh.
+var schedulableArchitectures = &architecturesStore{}
+
+type architecturesStore struct {
+	mu            sync.RWMutex
+	architectures sets.Set[string]
+}
+
+func (s *architecturesStore) store(architectures sets.Set[string]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.architectures = architectures
+}
+
+func (s *architecturesStore) load() sets.Set[string] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.architectures
+}
+
+type freeCapacityStore struct {
+	mu       sync.RWMutex
+	capacity map[string]freeCapacity
+}
+
+func (s *freeCapacityStore) store(capacity map[string]freeCapacity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.capacity = capacity
+}
+
+func (s *freeCapacityStore) load() map[string]freeCapacity {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.capacity
+}
+
+// NodeCapacitySyncer watches the nodes and periodically computes:
+//   - the architectures of the ready and schedulable nodes, used to detect the pods that no node can run;
+//   - the free allocatable CPU and memory of the schedulable nodes of each architecture, when at least one
+//     ClusterPodPlacementConfig or PodPlacementConfig sets the NodeAffinityScoring plugin in the Dynamic mode;
+//   - the cost hints of each architecture, when at least one of them enables the CostAwareScoring plugin.
+//
+// The free capacity and the cost hints are used to compute the weights of the preferred node affinity terms of the
+// pods.
+type NodeCapacitySyncer struct {
+	client     client.Client
+	apiReader  client.Reader
+	clientSet  *kubernetes.Clientset
+	nodeLister cache.Indexer
+	// podLister caches the non-terminated pods bound to a node, indexed by podsByNodeIndex. It is nil until the
+	// free capacity of the nodes is computed for the first time, so that the pods are not watched if no
+	// configuration uses the Dynamic mode.
+	podLister cache.Indexer
+}
+
+func NewNodeCapacitySyncer(client client.Client, apiReader client.Reader, clientSet *kubernetes.Clientset) *NodeCapacitySyncer {
+	return &NodeCapacitySyncer{
+		client:    client,
+		apiReader: apiReader,
+		clientSet: clientSet,
+	}
+}
+
+func (s *NodeCapacitySyncer) Start(ctx context.Context) error {
+	log := ctrllog.FromContext(ctx, "runnable", "NodeCapacitySyncer")
+	ctx = ctrllog.IntoContext(ctx, log)
+	log.Info("Starting the node capacity syncer")
+	nodeInformer := clientv1.NewNodeInformer(s.clientSet, nodesResyncPeriod, cache.Indexers{})
+	s.nodeLister = nodeInformer.GetIndexer()
+	go nodeInformer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), nodeInformer.HasSynced) {
+		return errors.New("failed to sync the nodes informer")
+	}
+	wait.UntilWithContext(ctx, s.refresh, nodeCapacityRefreshPeriod)
+	log.Info("Stopping the node capacity syncer")
+	return nil
+}
+
+// refresh recomputes the architectures of the schedulable nodes, the free capacity of the nodes if any configuration
+// uses the Dynamic mode, and the cost hints of the architectures if any configuration enables the CostAwareScoring
+// plugin.
+func (s *NodeCapacitySyncer) refresh(ctx context.Context) {
+	log := ctrllog.FromContext(ctx)
+	schedulableArchitectures.store(s.schedulableArchitectures())
+	dynamic, costAware, err := s.configuredScoring(ctx)
+	if err != nil {
+		log.Error(err, "Unable to list the PodPlacementConfigs")
+		return
+	}
+	if !dynamic {
+		architecturesFreeCapacity.store(nil)
+	} else if capacity, err := s.computeFreeCapacity(ctx); err != nil {
+		log.Error(err, "Unable to compute the free capacity of the nodes")
+	} else {
+		log.V(2).Info("Refreshed the free capacity of the nodes", "capacity", capacity)
+		architecturesFreeCapacity.store(capacity)
+	}
+	if !costAware {
+		architecturesCostHints.store(nil, nil)
+	} else if configMapCosts, err := s.configMapCostHints(ctx); err != nil {
+		log.Error(err, "Unable to read the cost hints ConfigMap")
+	} else {
+		nodeLabelsCosts := s.nodeLabelsCostHints()
+		log.V(2).Info("Refreshed the cost hints", "nodeLabels", nodeLabelsCosts, "configMap", configMapCosts)
+		architecturesCostHints.store(nodeLabelsCosts, configMapCosts)
+	}
+}
+
+// configuredScoring returns whether the ClusterPodPlacementConfig or any PodPlacementConfig sets the
+// NodeAffinityScoring plugin in the Dynamic mode, and whether any of them enables the CostAwareScoring plugin.
+func (s *NodeCapacitySyncer) configuredScoring(ctx context.Context) (dynamic bool, costAware bool, err error) {
+	check := func(pluginsEnabled func(common.Plugin) bool, nodeAffinityScoring *plugins.NodeAffinityScoring) {
+		dynamic = dynamic || pluginsEnabled(common.NodeAffinityScoringPluginName) && nodeAffinityScoring.IsDynamic()
+		costAware = costAware || pluginsEnabled(common.CostAwareScoringPluginName)
+	}
+	if cppc := clusterpodplacementconfig.GetClusterPodPlacementConfig(); cppc != nil && cppc.Spec.Plugins != nil {
+		check(cppc.PluginsEnabled, cppc.Spec.Plugins.NodeAffinityScoring)
+	}
+	if dynamic && costAware {
+		return dynamic, costAware, nil
+	}
+	ppcList := &v1beta1.PodPlacementConfigList{}
+	if err := s.client.List(ctx, ppcList); err != nil {
+		return false, false, err
+	}
+	for i := range ppcList.Items {
+		if ppc := &ppcList.Items[i]; ppc.Spec.Plugins != nil {
+			check(ppc.PluginsEnabled, ppc.Spec.Plugins.NodeAffinityScoring)
+		}
+	}
+	return dynamic, costAware, nil
+}
+
+// schedulableArchitectures returns the architectures of the ready and schedulable nodes. The nodes without the
+// kubernetes.io/arch label are ignored.
+func (s *NodeCapacitySyncer) schedulableArchitectures() sets.Set[string] {
+	architectures := sets.New[string]()
+	for _, obj := range s.nodeLister.List() {
+		node, ok := obj.(*corev1.Node)
+		if !ok || !isNodeSchedulable(node) {
+			continue
+		}
+		if architecture, ok := node.Labels[utils.ArchLabel]; ok && architecture != "" {
+			architectures.Insert(architecture)
+		}
+	}
+	return architectures
+}
+
+// computeFreeCapacity returns the free allocatable CPU and memory of the schedulable nodes of each architecture.
+// The free capacity of a node is its allocatable capacity minus the requests of the non-terminated pods bound to it.
+func (s *NodeCapacitySyncer) computeFreeCapacity(ctx context.Context) (map[string]freeCapacity, error) {
+	if err := s.ensurePodInformer(ctx); err != nil {
+		return nil, err
+	}
+	capacity := map[string]freeCapacity{}
+	for _, obj := range s.nodeLister.List() {
+		node, ok := obj.(*corev1.Node)
+		if !ok || !isNodeSchedulable(node) {
+			continue
+		}
+		cpu := node.Status.Allocatable.Cpu().MilliValue()
+		memory := node.Status.Allocatable.Memory().Value()
+		pods, err := s.podLister.ByIndex(podsByNodeIndex, node.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range pods {
+			if pod, ok := obj.(*corev1.Pod); ok {
+				podCPU, podMemory := podRequests(pod)
+				cpu -= podCPU
+				memory -= podMemory
+			}
+		}
+		c := capacity[node.Labels[utils.ArchLabel]]
+		c.cpu += max(cpu, 0)
+		c.memory += max(memory, 0)
+		capacity[node.Labels[utils.ArchLabel]] = c
+	}
+	return capacity, nil
+}
+
+// ensurePodInformer starts the informer of the non-terminated pods bound to a node, if not started yet, and waits
+// for its cache to sync. The informer runs until the given context is done.
+func (s *NodeCapacitySyncer) ensurePodInformer(ctx context.Context) error {
+	if s.podLister != nil {
+		return nil
+	}
+	selector := fields.AndSelectors(
+		fields.OneTermNotEqualSelector("spec.nodeName", ""),
+		fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+		fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+	).String()
+	podInformer := clientv1.NewFilteredPodInformer(s.clientSet, metav1.NamespaceAll, nodesResyncPeriod,
+		cache.Indexers{podsByNodeIndex: indexPodByNode}, func(options *metav1.ListOptions) {
+			options.FieldSelector = selector
+		})
+	if err := podInformer.SetTransform(transformPodRequests); err != nil {
+		return err
+	}
+	go podInformer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), podInformer.HasSynced) {
+		return errors.New("failed to sync the pods informer")
+	}
+	s.podLister = podInformer.GetIndexer()
+	return nil
+}
+
+// indexPodByNode indexes the pods by the name of their node.
+func indexPodByNode(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil, nil
+	}
+	return []string{pod.Spec.NodeName}, nil
+}
+
+// transformPodRequests strips the pods cached by the pods informer down to the fields used by podRequests, to limit
+// the memory used by the cache of all the pods of the cluster.
+func transformPodRequests(obj interface{}) (interface{}, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return obj, nil
+	}
+	stripped := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pod.Name,
+			Namespace:       pod.Namespace,
+			UID:             pod.UID,
+			ResourceVersion: pod.ResourceVersion,
+		},
+		Spec: corev1.PodSpec{
+			NodeName: pod.Spec.NodeName,
+			Overhead: pod.Spec.Overhead,
+		},
+	}
+	for _, container := range pod.Spec.Containers {
+		stripped.Spec.Containers = append(stripped.Spec.Containers, corev1.Container{
+			Resources: corev1.ResourceRequirements{Requests: container.Resources.Requests},
+		})
+	}
+	for _, container := range pod.Spec.InitContainers {
+		stripped.Spec.InitContainers = append(stripped.Spec.InitContainers, corev1.Container{
+			Resources: corev1.ResourceRequirements{Requests: container.Resources.Requests},
+		})
+	}
+	return stripped, nil
+}
+
+// isNodeSchedulable returns true if the node is ready and not cordoned.
+func isNodeSchedulable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// podRequests returns the CPU, in millicores, and memory, in bytes, requested by the pod. As for the scheduler, the
+// requests of the pod are the maximum between the sum of the requests of its containers and the requests of each of
+// its init containers, plus the pod overhead.
+func podRequests(pod *corev1.Pod) (int64, int64) {
+	var cpu, memory int64
+	for _, container := range pod.Spec.Containers {
+		cpu += container.Resources.Requests.Cpu().MilliValue()
+		memory += container.Resources.Requests.Memory().Value()
+	}
+	for _, container := range pod.Spec.InitContainers {
+		cpu = max(cpu, container.Resources.Requests.Cpu().MilliValue())
+		memory = max(memory, container.Resources.Requests.Memory().Value())
+	}
+	cpu += pod.Spec.Overhead.Cpu().MilliValue()
+	memory += pod.Spec.Overhead.Memory().Value()
+	return cpu, memory
+}
+
+// withDynamicWeights returns the NodeAffinityScoring plugin with the weights computed from the share of the free
+// capacity of each architecture among the architectures of the platforms, when the plugin uses the Dynamic mode.
+// The share of an architecture is the average of its shares of the free CPU and memory, mapped to the range 1-100.
+// The plugin is returned unchanged when it uses the Static mode or the free capacity of the nodes is not known yet.
+func withDynamicWeights(nodeAffinityScoring *plugins.NodeAffinityScoring) *plugins.NodeAffinityScoring {
+	if !nodeAffinityScoring.IsDynamic() {
+		return nodeAffinityScoring
+	}
+	return computeDynamicWeights(nodeAffinityScoring, architecturesFreeCapacity.load())
+}
+
+func computeDynamicWeights(nodeAffinityScoring *plugins.NodeAffinityScoring,
+	capacity map[string]freeCapacity) *plugins.NodeAffinityScoring {
+	var total freeCapacity
+	for _, platformTerm := range nodeAffinityScoring.Platforms {
+		total.cpu += capacity[platformTerm.Architecture].cpu
+		total.memory += capacity[platformTerm.Architecture].memory
+	}
+	if total.cpu == 0 && total.memory == 0 {
+		return nodeAffinityScoring
+	}
+	dynamic := nodeAffinityScoring.DeepCopy()
+	for i := range dynamic.Platforms {
+		var share float64
+		var resources int
+		if total.cpu > 0 {
+			share += float64(capacity[dynamic.Platforms[i].Architecture].cpu) / float64(total.cpu)
+			resources++
+		}
+		if total.memory > 0 {
+			share += float64(capacity[dynamic.Platforms[i].Architecture].memory) / float64(total.memory)
+			resources++
+		}
+		weight := int32(math.Round(share / float64(resources) * maxPreferredSchedulingTermWeight))
+		dynamic.Platforms[i].Weight = min(max(weight, 1), maxPreferredSchedulingTermWeight)
+	}
+	return dynamic
+}
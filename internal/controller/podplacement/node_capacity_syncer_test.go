@@ -0,0 +1,268 @@
+package podplacement
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+
+	. "github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
+)
+
+func Test_computeDynamicWeights(t *testing.T) {
+	platforms := []plugins.NodeAffinityScoringPlatformTerm{
+		{Architecture: utils.ArchitectureAmd64, Weight: 50},
+		{Architecture: utils.ArchitectureArm64, Weight: 50},
+	}
+	tests := []struct {
+		name     string
+		capacity map[string]freeCapacity
+		want     []int32
+	}{
+		{
+			name:     "unknown capacity keeps the static weights",
+			capacity: nil,
+			want:     []int32{50, 50},
+		},
+		{
+			name: "no free capacity for the platforms keeps the static weights",
+			capacity: map[string]freeCapacity{
+				utils.ArchitecturePpc64le: {cpu: 4000, memory: 8 << 30},
+			},
+			want: []int32{50, 50},
+		},
+		{
+			name: "weights follow the average share of free CPU and memory",
+			capacity: map[string]freeCapacity{
+				utils.ArchitectureAmd64: {cpu: 1000, memory: 2 << 30},
+				utils.ArchitectureArm64: {cpu: 3000, memory: 6 << 30},
+				utils.ArchitectureS390x: {cpu: 16000, memory: 64 << 30},
+			},
+			want: []int32{25, 75},
+		},
+		{
+			name: "architectures without free capacity get the minimum weight",
+			capacity: map[string]freeCapacity{
+				utils.ArchitectureArm64: {cpu: 3000, memory: 6 << 30},
+			},
+			want: []int32{1, 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeAffinityScoring := &plugins.NodeAffinityScoring{
+				BasePlugin: plugins.BasePlugin{Enabled: true},
+				Platforms:  platforms,
+				Mode:       plugins.NodeAffinityScoringModeDynamic,
+			}
+			got := computeDynamicWeights(nodeAffinityScoring, tt.capacity)
+			var weights []int32
+			for _, platformTerm := range got.Platforms {
+				weights = append(weights, platformTerm.Weight)
+			}
+			if !reflect.DeepEqual(weights, tt.want) {
+				t.Errorf("computeDynamicWeights() weights = %v, want %v", weights, tt.want)
+			}
+			if platforms[0].Weight != 50 || platforms[1].Weight != 50 {
+				t.Errorf("computeDynamicWeights() modified the platforms of the plugin")
+			}
+		})
+	}
+}
+
+func Test_withDynamicWeights(t *testing.T) {
+	architecturesFreeCapacity.store(map[string]freeCapacity{
+		utils.ArchitectureAmd64: {cpu: 1000, memory: 1 << 30},
+		utils.ArchitectureArm64: {cpu: 3000, memory: 3 << 30},
+	})
+	defer architecturesFreeCapacity.store(nil)
+
+	static := NewClusterPodPlacementConfig().WithName("cluster").WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 50).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 50).Build()
+	if got := withDynamicWeights(static.Spec.Plugins.NodeAffinityScoring); got != static.Spec.Plugins.NodeAffinityScoring {
+		t.Errorf("withDynamicWeights() = %v, want the static plugin unchanged", got)
+	}
+	dynamic := NewClusterPodPlacementConfig().WithName("cluster").WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 50).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 50).
+		WithNodeAffinityScoringMode(plugins.NodeAffinityScoringModeDynamic).Build()
+	want := []plugins.NodeAffinityScoringPlatformTerm{
+		{Architecture: utils.ArchitectureAmd64, Weight: 25},
+		{Architecture: utils.ArchitectureArm64, Weight: 75},
+	}
+	if got := withDynamicWeights(dynamic.Spec.Plugins.NodeAffinityScoring); !reflect.DeepEqual(got.Platforms, want) {
+		t.Errorf("withDynamicWeights() = %v, want %v", got.Platforms, want)
+	}
+}
+
+func Test_podRequests(t *testing.T) {
+	requests := func(cpu, memory string) v1.ResourceRequirements {
+		return v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}}
+	}
+	tests := []struct {
+		name       string
+		spec       v1.PodSpec
+		wantCPU    int64
+		wantMemory int64
+	}{
+		{
+			name:       "no requests",
+			spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+			wantCPU:    0,
+			wantMemory: 0,
+		},
+		{
+			name: "sum of the containers requests",
+			spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "app", Resources: requests("500m", "1Gi")},
+				{Name: "sidecar", Resources: requests("250m", "512Mi")},
+			}},
+			wantCPU:    750,
+			wantMemory: 1536 << 20,
+		},
+		{
+			name: "init containers requests larger than the containers ones, plus the overhead",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{{Name: "init", Resources: requests("2", "256Mi")}},
+				Containers:     []v1.Container{{Name: "app", Resources: requests("500m", "1Gi")}},
+				Overhead: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+			},
+			wantCPU:    2100,
+			wantMemory: 1088 << 20,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cpu, memory := podRequests(&v1.Pod{Spec: tt.spec})
+			if cpu != tt.wantCPU || memory != tt.wantMemory {
+				t.Errorf("podRequests() = %d, %d, want %d, %d", cpu, memory, tt.wantCPU, tt.wantMemory)
+			}
+		})
+	}
+}
+
+func Test_isNodeSchedulable(t *testing.T) {
+	ready := v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}}
+	notReady := v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}}}
+	tests := []struct {
+		name string
+		node *v1.Node
+		want bool
+	}{
+		{
+			name: "ready node",
+			node: &v1.Node{Status: ready},
+			want: true,
+		},
+		{
+			name: "cordoned node",
+			node: &v1.Node{Spec: v1.NodeSpec{Unschedulable: true}, Status: ready},
+			want: false,
+		},
+		{
+			name: "not ready node",
+			node: &v1.Node{Status: notReady},
+			want: false,
+		},
+		{
+			name: "node without the ready condition",
+			node: &v1.Node{},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNodeSchedulable(tt.node); got != tt.want {
+				t.Errorf("isNodeSchedulable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeCapacitySyncer_schedulableArchitectures(t *testing.T) {
+	ready := v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}}
+	nodeLister := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, node := range []*v1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "amd64", Labels: map[string]string{utils.ArchLabel: utils.ArchitectureAmd64}},
+			Status: ready},
+		{ObjectMeta: metav1.ObjectMeta{Name: "arm64-cordoned", Labels: map[string]string{utils.ArchLabel: utils.ArchitectureArm64}},
+			Spec: v1.NodeSpec{Unschedulable: true}, Status: ready},
+		{ObjectMeta: metav1.ObjectMeta{Name: "s390x-not-ready", Labels: map[string]string{utils.ArchLabel: utils.ArchitectureS390x}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "no-architecture"}, Status: ready},
+	} {
+		if err := nodeLister.Add(node); err != nil {
+			t.Fatalf("failed to add the node %s to the lister: %v", node.Name, err)
+		}
+	}
+	s := &NodeCapacitySyncer{nodeLister: nodeLister}
+	if got := sets.List(s.schedulableArchitectures()); !reflect.DeepEqual(got, []string{utils.ArchitectureAmd64}) {
+		t.Errorf("schedulableArchitectures() = %v, want %v", got, []string{utils.ArchitectureAmd64})
+	}
+}
+
+func TestNodeCapacitySyncer_computeFreeCapacity(t *testing.T) {
+	ready := v1.NodeStatus{
+		Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+		Allocatable: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("4"),
+			v1.ResourceMemory: resource.MustParse("8Gi"),
+		},
+	}
+	nodeLister := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, node := range []*v1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "amd64", Labels: map[string]string{utils.ArchLabel: utils.ArchitectureAmd64}},
+			Status: ready},
+		{ObjectMeta: metav1.ObjectMeta{Name: "arm64", Labels: map[string]string{utils.ArchLabel: utils.ArchitectureArm64}},
+			Status: ready},
+		{ObjectMeta: metav1.ObjectMeta{Name: "arm64-cordoned", Labels: map[string]string{utils.ArchLabel: utils.ArchitectureArm64}},
+			Spec: v1.NodeSpec{Unschedulable: true}, Status: ready},
+	} {
+		if err := nodeLister.Add(node); err != nil {
+			t.Fatalf("failed to add the node %s to the lister: %v", node.Name, err)
+		}
+	}
+	podLister := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{podsByNodeIndex: indexPodByNode})
+	for _, pod := range []*v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "small", Namespace: "test"}, Spec: v1.PodSpec{NodeName: "amd64",
+			Containers: []v1.Container{{Name: "app", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("2Gi")}}}}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "large", Namespace: "test"}, Spec: v1.PodSpec{NodeName: "arm64",
+			Containers: []v1.Container{{Name: "app", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse("8"), v1.ResourceMemory: resource.MustParse("1Gi")}}}}}},
+	} {
+		obj, err := transformPodRequests(pod)
+		if err != nil {
+			t.Fatalf("failed to transform the pod %s: %v", pod.Name, err)
+		}
+		if err := podLister.Add(obj); err != nil {
+			t.Fatalf("failed to add the pod %s to the lister: %v", pod.Name, err)
+		}
+	}
+	s := &NodeCapacitySyncer{nodeLister: nodeLister, podLister: podLister}
+	got, err := s.computeFreeCapacity(context.Background())
+	if err != nil {
+		t.Fatalf("computeFreeCapacity() error = %v", err)
+	}
+	want := map[string]freeCapacity{
+		utils.ArchitectureAmd64: {cpu: 3000, memory: 6 << 30},
+		utils.ArchitectureArm64: {cpu: 0, memory: 7 << 30},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("computeFreeCapacity() = %v, want %v", got, want)
+	}
+}
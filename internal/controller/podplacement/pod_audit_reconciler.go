@@ -0,0 +1,159 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podplacement
+
+import (
+	"context"
+	"encoding/json"
+	runtime2 "runtime"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrl2 "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacement/metrics"
+	"github.com/openshift/multiarch-tuning-operator/pkg/informers/clusterpodplacementconfig"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+// auditRetryBaseDelay is the delay before the first retry of the audit of a pod whose image inspection failed. It is
+// doubled at each retry.
+const auditRetryBaseDelay = 5 * time.Second
+
+// PodAuditReconciler reconciles the pods labeled for audit by the webhook when the ClusterPodPlacementConfig
+// is in Audit mode. It computes the node affinity the PodReconciler would set on a copy of the pod and records it
+// in the pod's annotations, events and metrics, without modifying the scheduling constraints of the pod.
+type PodAuditReconciler struct {
+	PodReconciler
+}
+
+// Reconcile audits the pods with the utils.AuditLabel label set to utils.AuditLabelValuePending.
+func (r *PodAuditReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	metrics.InitPodPlacementControllerMetrics()
+	log := ctrllog.FromContext(ctx)
+
+	pod := newPod(&corev1.Pod{}, ctx, r.Recorder)
+	// The audited pods are not gated and can leave the Pending phase, and therefore the cache, before they are
+	// reconciled. We read them from the API server.
+	if err := r.APIReader.Get(ctx, req.NamespacedName, pod.PodObject()); err != nil {
+		log.V(2).Info("Unable to fetch pod", "error", err)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if pod.Labels[utils.AuditLabel] != utils.AuditLabelValuePending {
+		log.V(2).Info("Pod is not pending audit. Ignoring...")
+		return ctrl.Result{}, nil
+	}
+	result := r.auditPod(ctx, pod)
+	if err := r.Update(ctx, pod.PodObject()); err != nil {
+		log.Error(err, "Unable to update the pod")
+		return ctrl.Result{}, err
+	}
+	if result == utils.AuditLabelValuePending {
+		retryAfter := auditRetryDelay(pod)
+		log.V(1).Info("Retrying the audit of the pod", "after", retryAfter)
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+	metrics.AuditedPods.WithLabelValues(result).Inc()
+	return ctrl.Result{}, nil
+}
+
+// auditPod computes the node affinity the PodReconciler would set on the pod and records it in the pod's metadata.
+// It returns the value set for the utils.AuditLabel label.
+func (r *PodAuditReconciler) auditPod(ctx context.Context, pod *Pod) string {
+	log := ctrllog.FromContext(ctx)
+	log.V(1).Info("Auditing pod")
+
+	// The simulation runs on a copy of the pod with no event recorder: the copy is never persisted, and the events
+	// about its changes would be misleading for the users.
+	simulatedPod := newPod(pod.DeepCopy(), ctx, nil)
+	cppc := clusterpodplacementconfig.GetClusterPodPlacementConfig()
+	matchingPPCs, err := r.listMatchingPPCs(ctx, simulatedPod)
+	if err != nil {
+		return r.auditFailed(pod, err.Error())
+	}
+	if simulatedPod.shouldIgnorePod(cppc, matchingPPCs) {
+		log.V(2).Info("The pod would have been ignored")
+		pod.EnsureLabel(utils.AuditLabel, utils.AuditLabelValueIgnored)
+		return utils.AuditLabelValueIgnored
+	}
+	r.setPlacement(ctx, simulatedPod, cppc, matchingPPCs)
+	if _, ok := simulatedPod.Labels[utils.ImageInspectionErrorLabel]; ok {
+		if !simulatedPod.maxRetries() {
+			// As for the gated pods, the image inspection errors may be transient: the number of attempts is tracked
+			// in the pod's labels and the audit is retried until it reaches MaxRetryCount.
+			log.V(1).Info("Unable to inspect the images of the pod", "error",
+				simulatedPod.Annotations[utils.ImageInspectionErrorLabel])
+			pod.EnsureLabel(utils.ImageInspectionErrorCountLabel, simulatedPod.Labels[utils.ImageInspectionErrorCountLabel])
+			return utils.AuditLabelValuePending
+		}
+		return r.auditFailed(pod, simulatedPod.Annotations[utils.ImageInspectionErrorLabel])
+	}
+
+	nodeAffinity := &corev1.NodeAffinity{}
+	if simulatedPod.Spec.Affinity != nil && simulatedPod.Spec.Affinity.NodeAffinity != nil {
+		nodeAffinity = simulatedPod.Spec.Affinity.NodeAffinity
+	}
+	marshaledNodeAffinity, err := json.Marshal(nodeAffinity)
+	if err != nil {
+		return r.auditFailed(pod, err.Error())
+	}
+	pod.EnsureAnnotation(utils.AuditNodeAffinityAnnotation, string(marshaledNodeAffinity))
+	pod.EnsureLabel(utils.AuditLabel, utils.AuditLabelValueAudited)
+	pod.PublishEvent(corev1.EventTypeNormal, ArchitectureAwareAudited, AuditNodeAffinityMsg)
+	log.V(2).Info("Pod audited", "nodeAffinity", string(marshaledNodeAffinity))
+	return utils.AuditLabelValueAudited
+}
+
+// auditRetryDelay returns the delay before the next audit of the pod, doubling auditRetryBaseDelay for each failed
+// attempt recorded in the pod's labels.
+func auditRetryDelay(pod *Pod) time.Duration {
+	attempts, err := strconv.Atoi(pod.Labels[utils.ImageInspectionErrorCountLabel])
+	if err != nil || attempts < 1 {
+		return auditRetryBaseDelay
+	}
+	return auditRetryBaseDelay << min(attempts-1, MaxRetryCount)
+}
+
+func (r *PodAuditReconciler) auditFailed(pod *Pod, errMsg string) string {
+	pod.EnsureLabel(utils.AuditLabel, utils.AuditLabelValueError)
+	pod.PublishEvent(corev1.EventTypeWarning, ArchitectureAwareAuditFailure, AuditFailureMsg+errMsg)
+	return utils.AuditLabelValueError
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PodAuditReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("pod-audit").
+		For(&corev1.Pod{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			return object.GetLabels()[utils.AuditLabel] == utils.AuditLabelValuePending
+		}), predicate.Funcs{
+			// The webhook labels the pods for audit at their creation. The updates of the pods pending audit are
+			// ignored so that the retries recorded in their labels are delayed by auditRetryDelay.
+			UpdateFunc: func(event.UpdateEvent) bool { return false },
+		})).
+		WithOptions(ctrl2.Options{
+			MaxConcurrentReconciles: runtime2.NumCPU() * 4,
+		}).
+		Complete(r)
+}
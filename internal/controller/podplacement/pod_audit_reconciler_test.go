@@ -0,0 +1,49 @@
+package podplacement
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+
+	. "github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
+)
+
+func TestAuditRetryDelay(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want time.Duration
+	}{
+		{
+			name: "pod with no failed attempt",
+			pod:  NewPod().Build(),
+			want: auditRetryBaseDelay,
+		},
+		{
+			name: "pod with one failed attempt",
+			pod:  NewPod().WithLabels(utils.ImageInspectionErrorCountLabel, "1").Build(),
+			want: auditRetryBaseDelay,
+		},
+		{
+			name: "pod with three failed attempts",
+			pod:  NewPod().WithLabels(utils.ImageInspectionErrorCountLabel, "3").Build(),
+			want: 4 * auditRetryBaseDelay,
+		},
+		{
+			name: "pod with an invalid number of failed attempts",
+			pod:  NewPod().WithLabels(utils.ImageInspectionErrorCountLabel, "invalid").Build(),
+			want: auditRetryBaseDelay,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(auditRetryDelay(newPod(tt.pod, ctx, nil))).To(Equal(tt.want))
+		})
+	}
+}
@@ -18,14 +18,15 @@ package podplacement
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
@@ -44,7 +45,11 @@ var (
 	imageInspectionCache image.ICache = image.FacadeSingleton()
 )
 
-const MaxRetryCount = 5
+const (
+	MaxRetryCount = 5
+	// maxPreferredSchedulingTermWeight is the maximum weight of a preferred scheduling term.
+	maxPreferredSchedulingTermWeight = 100
+)
 
 type containerImage struct {
 	imageName string
@@ -53,6 +58,9 @@ type containerImage struct {
 
 type Pod struct {
 	models.Pod
+	// containersArchitectures stores the architectures supported by the image of each container, keyed by container
+	// name, as computed by intersectImagesArchitecture.
+	containersArchitectures map[string]sets.Set[string]
 }
 
 func newPod(pod *corev1.Pod, ctx context.Context, recorder record.EventRecorder) *Pod {
@@ -94,11 +102,15 @@ func (pod *Pod) getPodImagePullSecrets() []string {
 }
 
 // SetNodeAffinityArchRequirement wraps the logic to set the nodeAffinity for the pod.
-// It verifies first that no nodeSelector field is set for the kubernetes.io/arch label.
+// It verifies first that no nodeSelector field is set for the kubernetes.io/arch label, or for the equivalent
+// architecture label keys configured in the ClusterPodPlacementConfig.
+// The verification is skipped when the ClusterPodPlacementConfig asks to intersect the architecture constraints set by the user.
 // Then, it computes the intersection of the architectures supported by the images used by the pod via pod.getArchitecturePredicate.
-// Finally, it initializes the nodeAffinity for the pod and set it to the computed requirement via the pod.setRequiredArchNodeAffinity method.
-func (pod *Pod) SetNodeAffinityArchRequirement(pullSecretDataList [][]byte) (bool, error) {
-	if pod.isNodeSelectorConfiguredForArchitecture() {
+// Finally, it initializes the nodeAffinity for the pod and set it to the computed requirement via the pod.setRequiredArchNodeAffinity method,
+// or narrows the architecture constraints set by the user via the pod.intersectRequiredArchNodeAffinity method.
+func (pod *Pod) SetNodeAffinityArchRequirement(pullSecretDataList [][]byte, cppc *v1beta1.ClusterPodPlacementConfig) (bool, error) {
+	intersect := cppc.IntersectArchitectureConstraints()
+	if !intersect && pod.isNodeSelectorConfiguredForArchitecture(cppc) {
 		pod.publishIgnorePod()
 		return false, nil
 	}
@@ -108,7 +120,10 @@ func (pod *Pod) SetNodeAffinityArchRequirement(pullSecretDataList [][]byte) (boo
 	}
 	pod.EnsureNoLabel(utils.ImageInspectionErrorLabel)
 	if len(requirement.Values) == 0 {
-		pod.PublishEvent(corev1.EventTypeNormal, NoSupportedArchitecturesFound, NoSupportedArchitecturesFoundMsg)
+		pod.PublishEvent(corev1.EventTypeNormal, NoSupportedArchitecturesFound, NoSupportedArchitecturesFoundMsg+
+			fmt.Sprintf(NoSupportedArchitecturesContainersMsg, strings.Join(pod.incompatibleContainers(), ", ")))
+	} else {
+		pod.checkCompatibleNodes(requirement)
 	}
 	pod.ensureArchitectureLabels(requirement)
 
@@ -124,15 +139,47 @@ func (pod *Pod) SetNodeAffinityArchRequirement(pullSecretDataList [][]byte) (boo
 		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
 	}
 
-	pod.setRequiredArchNodeAffinity(requirement)
+	if intersect && requirement.Key == utils.ArchLabel {
+		pod.intersectRequiredArchNodeAffinity(requirement, cppc.ArchitectureLabelKeys())
+	} else {
+		pod.setRequiredArchNodeAffinity(requirement, cppc.ArchitectureLabelKeys())
+	}
 	pod.PublishEvent(corev1.EventTypeNormal, ArchitectureAwareNodeAffinitySet,
 		ArchitecturePredicateSetupMsg+fmt.Sprintf("{%s}", strings.Join(requirement.Values, ", ")))
 	return true, nil
 }
 
+// checkCompatibleNodes sets the utils.NoCompatibleNodesLabel label if none of the ready and schedulable nodes has one
+// of the architectures of the given requirement. The label is removed otherwise. An event is published when the label
+// is first set: the pods kept gated by the KeepGated NoCompatibleNodesPolicy are checked again periodically.
+// The check is skipped until the architectures of the nodes are known.
+func (pod *Pod) checkCompatibleNodes(requirement corev1.NodeSelectorRequirement) {
+	nodeArchitectures := schedulableArchitectures.load()
+	if nodeArchitectures == nil || nodeArchitectures.HasAny(requirement.Values...) {
+		pod.EnsureNoLabel(utils.NoCompatibleNodesLabel)
+		return
+	}
+	if pod.hasNoCompatibleNodes() {
+		return
+	}
+	ctrllog.FromContext(pod.Ctx()).Info("No ready and schedulable node supports the architectures of the pod",
+		"architectures", requirement.Values, "nodeArchitectures", sets.List(nodeArchitectures))
+	pod.EnsureLabel(utils.NoCompatibleNodesLabel, "")
+	pod.PublishEvent(corev1.EventTypeWarning, NoCompatibleNodes, fmt.Sprintf(NoCompatibleNodesMsg,
+		strings.Join(requirement.Values, ", "), strings.Join(sets.List(nodeArchitectures), ", ")))
+}
+
+// hasNoCompatibleNodes returns true if none of the ready and schedulable nodes supports the architectures of the pod,
+// as reported by checkCompatibleNodes.
+func (pod *Pod) hasNoCompatibleNodes() bool {
+	_, ok := pod.Labels[utils.NoCompatibleNodesLabel]
+	return ok
+}
+
 // setRequiredArchNodeAffinity sets the node affinity for the pod to the given requirement based on the rules in
 // the sig-scheduling's KEP-3838: https://github.com/kubernetes/enhancements/tree/master/keps/sig-scheduling/3838-pod-mutable-scheduling-directives.
-func (pod *Pod) setRequiredArchNodeAffinity(requirement corev1.NodeSelectorRequirement) {
+// The nodeSelectorTerms already constraining one of the given architecture label keys are left unchanged.
+func (pod *Pod) setRequiredArchNodeAffinity(requirement corev1.NodeSelectorRequirement, archLabelKeys sets.Set[string]) {
 	// the .requiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms are ORed
 	if len(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) == 0 {
 		// We create a new array of NodeSelectorTerm of length 1 so that we can always iterate it in the next.
@@ -144,22 +191,13 @@ func (pod *Pod) setRequiredArchNodeAffinity(requirement corev1.NodeSelectorRequi
 	// Therefore, we iterate over the nodeSelectorTerms and add an expression to each of the terms to verify the
 	// kubernetes.io/arch label has compatible values.
 	// Note that the NodeSelectorTerms will always be long at least 1, because we (re-)created it with size 1 above if it was nil (or having 0 length).
-	var skipMatchExpressionPatch bool
 	for i := range nodeSelectorTerms {
-		skipMatchExpressionPatch = false
 		if nodeSelectorTerms[i].MatchExpressions == nil {
 			nodeSelectorTerms[i].MatchExpressions = make([]corev1.NodeSelectorRequirement, 0, 1)
 		}
-		// Check if the nodeSelectorTerm already has a matchExpression for the kubernetes.io/arch label.
-		// if yes, we ignore to add it.
-		for _, expression := range nodeSelectorTerms[i].MatchExpressions {
-			if expression.Key == requirement.Key {
-				skipMatchExpressionPatch = true
-				break
-			}
-		}
-		// if skipMatchExpressionPatch is true, we skip to add the matchExpression so that conflictual matchExpressions provided by the user are not overwritten.
-		if !skipMatchExpressionPatch {
+		// Check if the nodeSelectorTerm already constrains the architecture of the nodes.
+		// if yes, we skip to add the matchExpression so that conflictual matchExpressions provided by the user are not overwritten.
+		if !isArchitectureConstrainedTerm(nodeSelectorTerms[i], archLabelKeys.Union(sets.New(requirement.Key))) {
 			nodeSelectorTerms[i].MatchExpressions = append(nodeSelectorTerms[i].MatchExpressions, requirement)
 		}
 	}
@@ -168,6 +206,56 @@ func (pod *Pod) setRequiredArchNodeAffinity(requirement corev1.NodeSelectorRequi
 	pod.EnsureLabel(utils.NodeAffinityLabel, utils.NodeAffinityLabelValueSet)
 }
 
+// intersectRequiredArchNodeAffinity narrows the architecture constraints set by the user to the architectures of the
+// given requirement. In each nodeSelectorTerm, the values of the In expressions on the architecture label keys are
+// intersected with the requirement values. The requirement is added to the nodeSelectorTerms that have no such
+// expression, or whose intersection is empty, so that the nodes they select are constrained too.
+// The nodeSelectorTerms selecting the nodes by name are left unchanged. The nodeSelector of the pod is not modified, as
+// it is ANDed with the node affinity. A warning event lists the architectures allowed by the user that are not
+// supported by the images.
+func (pod *Pod) intersectRequiredArchNodeAffinity(requirement corev1.NodeSelectorRequirement, archLabelKeys sets.Set[string]) {
+	supported := sets.New(requirement.Values...)
+	unsupported := sets.New[string]()
+	for key, value := range pod.Spec.NodeSelector {
+		if archLabelKeys.Has(key) && !supported.Has(value) {
+			unsupported.Insert(value)
+		}
+	}
+	if len(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) == 0 {
+		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = make([]corev1.NodeSelectorTerm, 1)
+	}
+	nodeSelectorTerms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for i := range nodeSelectorTerms {
+		if selectsNodesByName(nodeSelectorTerms[i]) {
+			continue
+		}
+		narrowed := false
+		for j := range nodeSelectorTerms[i].MatchExpressions {
+			expression := &nodeSelectorTerms[i].MatchExpressions[j]
+			if !archLabelKeys.Has(expression.Key) || expression.Operator != corev1.NodeSelectorOpIn {
+				continue
+			}
+			allowed := sets.New(expression.Values...)
+			unsupported = unsupported.Union(allowed.Difference(supported))
+			// An In expression cannot have empty values: the requirement is added instead.
+			if values := allowed.Intersection(supported); values.Len() > 0 {
+				expression.Values = sets.List(values)
+				narrowed = true
+			}
+		}
+		if !narrowed {
+			nodeSelectorTerms[i].MatchExpressions = append(nodeSelectorTerms[i].MatchExpressions, requirement)
+		}
+	}
+	pod.EnsureLabel(utils.NodeAffinityLabel, utils.NodeAffinityLabelValueSet)
+	if unsupported.Len() > 0 {
+		ctrllog.FromContext(pod.Ctx()).Info("The architecture constraints of the pod allow architectures not supported by its images",
+			"unsupported", sets.List(unsupported), "supported", requirement.Values)
+		pod.PublishEvent(corev1.EventTypeWarning, UnsupportedUserArchitectures, fmt.Sprintf(UnsupportedUserArchitecturesMsg,
+			strings.Join(sets.List(unsupported), ", "), strings.Join(requirement.Values, ", ")))
+	}
+}
+
 // SetPreferredArchNodeAffinity sets the node affinity for the pod to the preferences given in the ClusterPodPlacementConfig.
 // The configSource parameter identifies which configuration is setting the preferences (e.g., "ClusterPodPlacementConfig" or "PodPlacementConfig/my-ppc").
 func (pod *Pod) SetPreferredArchNodeAffinity(nodeAffinity *plugins.NodeAffinityScoring, configSource string) {
@@ -189,19 +277,7 @@ func (pod *Pod) SetPreferredArchNodeAffinity(nodeAffinity *plugins.NodeAffinityS
 	var skippedArchitectures []string
 	for _, nodeAffinityScoringPlatformTerm := range nodeAffinity.Platforms {
 		if !seenArchitectures[nodeAffinityScoringPlatformTerm.Architecture] {
-			preferredSchedulingTerm := corev1.PreferredSchedulingTerm{
-				Weight: nodeAffinityScoringPlatformTerm.Weight,
-				Preference: corev1.NodeSelectorTerm{
-					MatchExpressions: []corev1.NodeSelectorRequirement{
-						{
-							Key:      utils.ArchLabel,
-							Operator: corev1.NodeSelectorOpIn,
-							Values:   []string{nodeAffinityScoringPlatformTerm.Architecture},
-						},
-					},
-				},
-			}
-			preferredSchedulingTerms = append(preferredSchedulingTerms, preferredSchedulingTerm)
+			preferredSchedulingTerms = append(preferredSchedulingTerms, newPreferredArchSchedulingTerm(nodeAffinityScoringPlatformTerm))
 			seenArchitectures[nodeAffinityScoringPlatformTerm.Architecture] = true
 			// Track that this architecture was applied from this source
 			pod.trackAffinitySource(nodeAffinityScoringPlatformTerm.Architecture, nodeAffinityScoringPlatformTerm.Weight, configSource, true)
@@ -240,6 +316,216 @@ func (pod *Pod) SetPreferredArchNodeAffinity(nodeAffinity *plugins.NodeAffinityS
 	}
 }
 
+// newPreferredArchSchedulingTerm returns the preferred scheduling term for the architecture and weight of the given
+// NodeAffinityScoring platform term.
+func newPreferredArchSchedulingTerm(platformTerm plugins.NodeAffinityScoringPlatformTerm) corev1.PreferredSchedulingTerm {
+	return corev1.PreferredSchedulingTerm{
+		Weight: platformTerm.Weight,
+		Preference: corev1.NodeSelectorTerm{
+			MatchExpressions: []corev1.NodeSelectorRequirement{
+				{
+					Key:      utils.ArchLabel,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{platformTerm.Architecture},
+				},
+			},
+		},
+	}
+}
+
+// nodeAffinityScoringSource is a configuration providing preferred node affinity terms to a pod.
+type nodeAffinityScoringSource struct {
+	// name identifies the configuration in the preferred node affinity sources annotation and in the events.
+	name                string
+	nodeAffinityScoring *plugins.NodeAffinityScoring
+}
+
+// nodeAffinityScoringSources returns the configurations providing preferred node affinity terms to the pod and the
+// strategy to merge them. The sources are sorted by descending priority: the matching PodPlacementConfigs with the
+// NodeAffinityScoring or the CostAwareScoring plugin enabled come first, followed by the ClusterPodPlacementConfig.
+// Each configuration is a single source, built by configNodeAffinityScoring.
+// The merge strategy is the one of the highest priority PodPlacementConfig, or FirstMatchWins if no
+// PodPlacementConfig provides preferred node affinity terms.
+// The webhook predicts whether the controller uses preferred node affinity terms through predictsPreferredNodeAffinity.
+func nodeAffinityScoringSources(cppc *v1beta1.ClusterPodPlacementConfig,
+	matchingPPCs []v1beta1.PodPlacementConfig) ([]nodeAffinityScoringSource, common.MergeStrategy) {
+	sortedPPCs := make([]v1beta1.PodPlacementConfig, len(matchingPPCs))
+	copy(sortedPPCs, matchingPPCs)
+	sort.SliceStable(sortedPPCs, func(i, j int) bool {
+		return sortedPPCs[i].Spec.Priority > sortedPPCs[j].Spec.Priority
+	})
+	var sources []nodeAffinityScoringSource
+	strategy := common.MergeStrategyFirstMatchWins
+	for i := range sortedPPCs {
+		ppc := &sortedPPCs[i]
+		if !hasPreferredAffinityPlugin(ppc.PluginsEnabled) {
+			continue
+		}
+		if len(sources) == 0 && ppc.Spec.MergeStrategy != "" {
+			strategy = ppc.Spec.MergeStrategy
+		}
+		nodeAffinityScoring := configNodeAffinityScoring(ppc.PluginsEnabled,
+			ppc.Spec.Plugins.NodeAffinityScoring, ppc.Spec.Plugins.CostAwareScoring)
+		sources = append(sources, nodeAffinityScoringSource{
+			name:                ppc.AffinitySource(),
+			nodeAffinityScoring: nodeAffinityScoring,
+		})
+	}
+	if cppc == nil || !hasPreferredAffinityPlugin(cppc.PluginsEnabled) {
+		return sources, strategy
+	}
+	nodeAffinityScoring := configNodeAffinityScoring(cppc.PluginsEnabled,
+		cppc.Spec.Plugins.NodeAffinityScoring, cppc.Spec.Plugins.CostAwareScoring)
+	sources = append(sources, nodeAffinityScoringSource{
+		name:                v1beta1.ClusterPodPlacementConfigKind,
+		nodeAffinityScoring: nodeAffinityScoring,
+	})
+	return sources, strategy
+}
+
+// configNodeAffinityScoring returns the preferred node affinity terms of a configuration: the terms of its
+// NodeAffinityScoring plugin, with the weights computed from the free capacity of the nodes in the Dynamic mode,
+// followed by the ones computed by its CostAwareScoring plugin. Both plugins of a configuration make a single source,
+// so that the merge strategies apply to the configuration as a whole.
+func configNodeAffinityScoring(pluginsEnabled func(common.Plugin) bool, nodeAffinityScoring *plugins.NodeAffinityScoring,
+	costAwareScoring *plugins.CostAwareScoring) *plugins.NodeAffinityScoring {
+	merged := &plugins.NodeAffinityScoring{BasePlugin: plugins.BasePlugin{Enabled: true}}
+	if pluginsEnabled(common.NodeAffinityScoringPluginName) {
+		merged.Platforms = append(merged.Platforms, withDynamicWeights(nodeAffinityScoring).Platforms...)
+	}
+	if pluginsEnabled(common.CostAwareScoringPluginName) {
+		merged.Platforms = append(merged.Platforms, costAwareNodeAffinityScoring(costAwareScoring).Platforms...)
+	}
+	return merged
+}
+
+// hasPreferredAffinityPlugin returns true if any of the plugins providing preferred node affinity terms is enabled,
+// according to the given pluginsEnabled function of a ClusterPodPlacementConfig or a PodPlacementConfig.
+func hasPreferredAffinityPlugin(pluginsEnabled func(common.Plugin) bool) bool {
+	return pluginsEnabled(common.NodeAffinityScoringPluginName) || pluginsEnabled(common.CostAwareScoringPluginName)
+}
+
+// predictsPreferredNodeAffinity returns true if the webhook can predict that the controller evaluates preferred node
+// affinity terms for the pod, i.e., the ClusterPodPlacementConfig or a matching PodPlacementConfig provides them and
+// none of them depends on the NodeCapacitySyncer. The weights of the Dynamic mode and the terms of the
+// CostAwareScoring plugin are computed from the free capacity and the cost hints collected by the NodeCapacitySyncer,
+// which only runs in the pod placement controller: the webhook does not predict them.
+func predictsPreferredNodeAffinity(cppc *v1beta1.ClusterPodPlacementConfig,
+	matchingPPCs []v1beta1.PodPlacementConfig) bool {
+	predicted := false
+	for i := range matchingPPCs {
+		ppc := &matchingPPCs[i]
+		if !hasPreferredAffinityPlugin(ppc.PluginsEnabled) {
+			continue
+		}
+		if usesNodeCapacitySyncer(ppc.PluginsEnabled, ppc.Spec.Plugins.NodeAffinityScoring) {
+			return false
+		}
+		predicted = true
+	}
+	if cppc == nil || !hasPreferredAffinityPlugin(cppc.PluginsEnabled) {
+		return predicted
+	}
+	return !usesNodeCapacitySyncer(cppc.PluginsEnabled, cppc.Spec.Plugins.NodeAffinityScoring)
+}
+
+// usesNodeCapacitySyncer returns true if the preferred node affinity terms of a configuration are computed from the
+// state collected by the NodeCapacitySyncer.
+func usesNodeCapacitySyncer(pluginsEnabled func(common.Plugin) bool, nodeAffinityScoring *plugins.NodeAffinityScoring) bool {
+	return pluginsEnabled(common.NodeAffinityScoringPluginName) && nodeAffinityScoring.IsDynamic() ||
+		pluginsEnabled(common.CostAwareScoringPluginName)
+}
+
+// setPreferredArchNodeAffinityFromSources sets the preferred node affinity of the pod by merging the terms of the
+// given sources, sorted by descending priority, according to the given merge strategy.
+func (pod *Pod) setPreferredArchNodeAffinityFromSources(sources []nodeAffinityScoringSource, strategy common.MergeStrategy) {
+	switch strategy {
+	case common.MergeStrategyOverride:
+		if len(sources) == 0 {
+			return
+		}
+		pod.SetPreferredArchNodeAffinity(sources[0].nodeAffinityScoring, sources[0].name)
+		for _, source := range sources[1:] {
+			pod.trackSkippedSource(source)
+		}
+	case common.MergeStrategyMergeByMaxWeight, common.MergeStrategySum:
+		pod.setMergedPreferredArchNodeAffinity(sources, strategy)
+	default:
+		for _, source := range sources {
+			pod.SetPreferredArchNodeAffinity(source.nodeAffinityScoring, source.name)
+		}
+	}
+}
+
+// setMergedPreferredArchNodeAffinity sets a single preferred scheduling term for each architecture provided by the
+// sources. With the MergeByMaxWeight strategy, the term gets the maximum weight among the sources and only the
+// source providing it is tracked as applied. With the Sum strategy, the term gets the sum of the weights of the
+// sources, capped at maxPreferredSchedulingTermWeight, and all the sources are tracked as applied.
+func (pod *Pod) setMergedPreferredArchNodeAffinity(sources []nodeAffinityScoringSource, strategy common.MergeStrategy) {
+	log := ctrllog.FromContext(pod.Ctx())
+	var mergedTerms []plugins.NodeAffinityScoringPlatformTerm
+	// indexes maps each architecture to the index of its merged term, winners to the source of its maximum weight
+	indexes := map[string]int{}
+	winners := map[string]string{}
+	for _, source := range sources {
+		for _, platformTerm := range source.nodeAffinityScoring.Platforms {
+			i, ok := indexes[platformTerm.Architecture]
+			switch {
+			case !ok:
+				indexes[platformTerm.Architecture] = len(mergedTerms)
+				mergedTerms = append(mergedTerms, platformTerm)
+				winners[platformTerm.Architecture] = source.name
+			case strategy == common.MergeStrategySum:
+				mergedTerms[i].Weight = min(mergedTerms[i].Weight+platformTerm.Weight, maxPreferredSchedulingTermWeight)
+			case platformTerm.Weight > mergedTerms[i].Weight:
+				mergedTerms[i].Weight = platformTerm.Weight
+				winners[platformTerm.Architecture] = source.name
+			}
+		}
+	}
+
+	seenArchitectures := pod.getExistingPreferredArchitectures()
+	var preferredSchedulingTerms []corev1.PreferredSchedulingTerm
+	for _, mergedTerm := range mergedTerms {
+		if !seenArchitectures[mergedTerm.Architecture] {
+			preferredSchedulingTerms = append(preferredSchedulingTerms, newPreferredArchSchedulingTerm(mergedTerm))
+		}
+	}
+	sourceNames := make([]string, 0, len(sources))
+	for _, source := range sources {
+		sourceNames = append(sourceNames, source.name)
+		for _, platformTerm := range source.nodeAffinityScoring.Platforms {
+			applied := !seenArchitectures[platformTerm.Architecture] &&
+				(strategy == common.MergeStrategySum || winners[platformTerm.Architecture] == source.name)
+			pod.trackAffinitySource(platformTerm.Architecture, platformTerm.Weight, source.name, applied)
+		}
+	}
+	if preferredSchedulingTerms == nil {
+		log.V(2).Info("No architecture preferences to merge", "MergeStrategy", strategy, "ConfigSources", sourceNames)
+		return
+	}
+
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+		pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, preferredSchedulingTerms...)
+	pod.EnsureLabel(utils.PreferredNodeAffinityLabel, utils.NodeAffinityLabelValueSet)
+	pod.PublishEvent(corev1.EventTypeNormal, ArchitectureAwareNodeAffinitySet, fmt.Sprintf("%s merge strategy: %s, sources: %s",
+		ArchitecturePreferredAffinityMergedMsg, strategy, strings.Join(sourceNames, ", ")))
+	log.V(2).Info("Applied the merged architecture preferences", "MergeStrategy", strategy, "ConfigSources", sourceNames)
+}
+
+// trackSkippedSource tracks all the architectures of the given source as skipped.
+func (pod *Pod) trackSkippedSource(source nodeAffinityScoringSource) {
+	for _, platformTerm := range source.nodeAffinityScoring.Platforms {
+		pod.trackAffinitySource(platformTerm.Architecture, platformTerm.Weight, source.name, false)
+	}
+}
+
 // getExistingPreferredArchitectures finds all
 // architectures that already have a preferred node affinity configured on the pod.
 func (pod *Pod) getExistingPreferredArchitectures() map[string]bool {
@@ -297,7 +583,7 @@ func (pod *Pod) trackAffinitySource(arch string, weight int32, source string, ap
 }
 
 // setRequiredNodeAffinityToFallbackArchitecture sets the node affinity for the pod to the fallback architecture.
-func (pod *Pod) setRequiredNodeAffinityToFallbackArchitecture(architecture string) {
+func (pod *Pod) setRequiredNodeAffinityToFallbackArchitecture(architecture string, cppc *v1beta1.ClusterPodPlacementConfig) {
 	requirement := corev1.NodeSelectorRequirement{
 		Key:      utils.ArchLabel,
 		Operator: corev1.NodeSelectorOpIn,
@@ -316,7 +602,7 @@ func (pod *Pod) setRequiredNodeAffinityToFallbackArchitecture(architecture strin
 		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
 	}
 
-	pod.setRequiredArchNodeAffinity(requirement)
+	pod.setRequiredArchNodeAffinity(requirement, cppc.ArchitectureLabelKeys())
 	pod.EnsureLabel(utils.FallbackArchitectureLabel, architecture)
 	pod.PublishEvent(corev1.EventTypeWarning, ArchitectureAwareFallbackNodeAffinitySet,
 		ArchitectureFallbackSetupMsg+fmt.Sprintf("{%s}", architecture))
@@ -345,14 +631,18 @@ func (pod *Pod) getArchitecturePredicate(pullSecretDataList [][]byte) (corev1.No
 func (pod *Pod) imagesNamesSet() sets.Set[containerImage] {
 	imageNamesSet := sets.New[containerImage]()
 	for _, container := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
-		imageNamesSet.Insert(containerImage{
-			imageName: fmt.Sprintf("//%s", container.Image),
-			skipCache: container.ImagePullPolicy == corev1.PullAlways,
-		})
+		imageNamesSet.Insert(newContainerImage(container))
 	}
 	return imageNamesSet
 }
 
+func newContainerImage(container corev1.Container) containerImage {
+	return containerImage{
+		imageName: fmt.Sprintf("//%s", container.Image),
+		skipCache: container.ImagePullPolicy == corev1.PullAlways,
+	}
+}
+
 // inspect returns the list of supported architectures for the images used by the pod.
 // if an error occurs, it returns the error and a nil slice of strings.
 func (pod *Pod) intersectImagesArchitecture(pullSecretDataList [][]byte) (supportedArchitectures []string, err error) {
@@ -362,6 +652,7 @@ func (pod *Pod) intersectImagesArchitecture(pullSecretDataList [][]byte) (suppor
 	// https://github.com/containers/skopeo/blob/v1.11.1/cmd/skopeo/inspect.go#L72
 	// Iterate over the images, get their architectures and intersect (as in set intersection) them each other
 	var supportedArchitecturesSet sets.Set[string]
+	imagesArchitectures := make(map[containerImage]sets.Set[string], len(imageNamesSet))
 	nowExternal := time.Now()
 	defer utils.HistogramObserve(nowExternal, metrics.TimeToInspectPodImages)
 	for imageContainer := range imageNamesSet {
@@ -377,15 +668,65 @@ func (pod *Pod) intersectImagesArchitecture(pullSecretDataList [][]byte) (suppor
 			log.V(1).Error(err, "Error inspecting the image", "imageName", imageContainer.imageName)
 			return nil, err
 		}
+		imagesArchitectures[imageContainer] = currentImageSupportedArchitectures
 		if supportedArchitecturesSet == nil {
 			supportedArchitecturesSet = currentImageSupportedArchitectures
 		} else {
 			supportedArchitecturesSet = supportedArchitecturesSet.Intersection(currentImageSupportedArchitectures)
 		}
 	}
+	pod.setContainersArchitectures(imagesArchitectures)
 	return sets.List(supportedArchitecturesSet), nil
 }
 
+// setContainersArchitectures stores the architectures supported by the image of each container and records them in the
+// multiarch.openshift.io/container-architectures annotation, as a JSON object mapping the container names to the
+// sorted lists of architectures.
+func (pod *Pod) setContainersArchitectures(imagesArchitectures map[containerImage]sets.Set[string]) {
+	pod.containersArchitectures = map[string]sets.Set[string]{}
+	annotation := map[string][]string{}
+	for _, container := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
+		architectures := imagesArchitectures[newContainerImage(container)]
+		if architectures == nil {
+			architectures = sets.New[string]()
+		}
+		pod.containersArchitectures[container.Name] = architectures
+		annotation[container.Name] = sets.List(architectures)
+	}
+	value, err := json.Marshal(annotation)
+	if err != nil {
+		ctrllog.FromContext(pod.Ctx()).Error(err, "Unable to marshal the architectures of the containers")
+		return
+	}
+	pod.EnsureAnnotation(utils.ContainerArchitecturesAnnotation, string(value))
+}
+
+// incompatibleContainers returns the sorted names of the containers whose images do not support the architecture
+// supported by the largest number of containers. When the images have no architecture in common, these are the
+// containers preventing the pod from running on the architecture that suits most of its containers.
+func (pod *Pod) incompatibleContainers() []string {
+	counts := map[string]int{}
+	for _, architectures := range pod.containersArchitectures {
+		for architecture := range architectures {
+			counts[architecture]++
+		}
+	}
+	mostSupported := ""
+	for _, architecture := range sets.List(sets.KeySet(counts)) {
+		if counts[architecture] > counts[mostSupported] {
+			mostSupported = architecture
+		}
+	}
+	var containers []string
+	for name, architectures := range pod.containersArchitectures {
+		if !architectures.Has(mostSupported) {
+			containers = append(containers, name)
+		}
+	}
+	sort.Strings(containers)
+	return containers
+}
+
 func (pod *Pod) maxRetries() bool {
 	if pod.Labels == nil {
 		return false
@@ -428,30 +769,40 @@ func (pod *Pod) ensureArchitectureLabels(requirement corev1.NodeSelectorRequirem
 // The operator should ignore the pods in the following cases:
 // - the pod is in the same namespace as the operator
 // - the pod is in a namespace with prefix kube-
+// - the pod is in a namespace matching the ignoredNamespaces of the ClusterPodPlacementConfig
+// - the pod has the multiarch.openshift.io/exclude-pod-placement label or annotation
 // - the pod has a node name set
 // - the pod has a node selector that matches the control plane nodes
 // - the pod is owned by a DaemonSet
-// - the pod has required architecture affinity configured AND:
+// - the pod has required architecture affinity configured, the CPPC does not intersect it, AND:
 //   - preferred affinity is already configured, OR
-//   - both CPPC and all matching PPCs have the NodeAffinityScoring plugin disabled
+//   - both CPPC and all matching PPCs have the NodeAffinityScoring and CostAwareScoring plugins disabled
 func (pod *Pod) shouldIgnorePod(cppc *v1beta1.ClusterPodPlacementConfig, matchingPPCs []v1beta1.PodPlacementConfig) bool {
+	return pod.isNamespaceIgnored(cppc) || pod.isOptedOut() || pod.Spec.NodeName != "" || pod.HasControlPlaneNodeSelector() || pod.IsFromDaemonSet() ||
+		!cppc.IntersectArchitectureConstraints() && pod.isNodeSelectorConfiguredForArchitecture(cppc) &&
+			(pod.isPreferredAffinityConfiguredForArchitecture(cppc) ||
+				(!hasPreferredAffinityPlugin(cppc.PluginsEnabled) && !pod.hasMatchingPPCWithPlugin(matchingPPCs)))
+}
+
+// isNamespaceIgnored returns true if the pod runs in the operator namespace, in a kube-* namespace or in one of the
+// ignoredNamespaces of the ClusterPodPlacementConfig.
+func (pod *Pod) isNamespaceIgnored(cppc *v1beta1.ClusterPodPlacementConfig) bool {
 	return utils.Namespace() == pod.Namespace || strings.HasPrefix(pod.Namespace, "kube-") ||
-		pod.Spec.NodeName != "" || pod.HasControlPlaneNodeSelector() || pod.IsFromDaemonSet() ||
-		pod.isNodeSelectorConfiguredForArchitecture() &&
-			(pod.isPreferredAffinityConfiguredForArchitecture() ||
-				(!cppc.PluginsEnabled(common.NodeAffinityScoringPluginName) && !pod.hasMatchingPPCWithPlugin(matchingPPCs)))
+		cppc.IsNamespaceIgnored(pod.Namespace)
 }
 
 // isNodeSelectorConfiguredForArchitecture returns true if the pod has already a nodeSelector for the architecture label
-// or if all the nodeSelectorTerms in the nodeAffinity field have a matchExpression for the architecture label.
-func (pod *Pod) isNodeSelectorConfiguredForArchitecture() bool {
+// or if all the nodeSelectorTerms in the nodeAffinity field constrain the architecture of the nodes.
+// The architecture label is kubernetes.io/arch or any of the equivalent keys configured in the ClusterPodPlacementConfig.
+func (pod *Pod) isNodeSelectorConfiguredForArchitecture(cppc *v1beta1.ClusterPodPlacementConfig) bool {
+	archLabelKeys := cppc.ArchitectureLabelKeys()
 	// if the pod has the nodeSelector field set for the kubernetes.io/arch label, we ignore it.
 	// in fact, the nodeSelector field is ANDed with the nodeAffinity field, and we want to give the user the main control, if they
 	// manually set a predicate for the kubernetes.io/arch label.
 	// The same behavior is implemented below within each
 	// nodeSelectorTerm's MatchExpressions field.
 	for key := range pod.Spec.NodeSelector {
-		if key == utils.ArchLabel {
+		if archLabelKeys.Has(key) {
 			pod.publishIgnorePod()
 			return true
 		}
@@ -464,27 +815,102 @@ func (pod *Pod) isNodeSelectorConfiguredForArchitecture() bool {
 
 	// Iterate over NodeSelectorTerms (terms are ORed)
 	for _, nodeSelectorTerm := range pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
-		// Assume the architecture label is not present
-		hasArchLabel := false
-
-		// Check all match expressions within the current NodeSelectorTerm (expressions are ANDed)
-		for _, matchExpression := range nodeSelectorTerm.MatchExpressions {
-			// If we find the architecture label, mark it as found
-			if matchExpression.Key == utils.ArchLabel {
-				hasArchLabel = true
-				break
+		// If one of the NodeSelectorTerms does not constrain the architecture, return false
+		if !isArchitectureConstrainedTerm(nodeSelectorTerm, archLabelKeys) {
+			return false
+		}
+	}
+
+	// If all NodeSelectorTerms constrain the architecture, return true
+	return true
+}
+
+// normalizeArchitectureLabels rewrites the architecture-equivalent node label keys configured in the
+// ClusterPodPlacementConfig to kubernetes.io/arch in the nodeSelector and in the nodeAffinity of the pod.
+// A key is not rewritten where kubernetes.io/arch is already constrained, so that the user's constraints are not
+// overwritten. In the nodeSelector, the equivalent keys are replaced by kubernetes.io/arch with the value of the first
+// of them: the ones with a different value are kept, as they conflict with it. The rewritten keys are listed in the
+// multiarch.openshift.io/normalized-arch-labels annotation.
+// The pods ignored by the operator, or processed in Audit mode, are not normalized.
+func (pod *Pod) normalizeArchitectureLabels(cppc *v1beta1.ClusterPodPlacementConfig) {
+	equivalentKeys := cppc.ArchitectureLabelKeys()
+	equivalentKeys.Delete(utils.ArchLabel)
+	normalized := sets.New[string]()
+	if _, ok := pod.Spec.NodeSelector[utils.ArchLabel]; !ok {
+		for _, key := range sets.List(equivalentKeys) {
+			value, ok := pod.Spec.NodeSelector[key]
+			if !ok {
+				continue
+			}
+			if architecture, ok := pod.Spec.NodeSelector[utils.ArchLabel]; ok && architecture != value {
+				continue
+			}
+			delete(pod.Spec.NodeSelector, key)
+			pod.Spec.NodeSelector[utils.ArchLabel] = value
+			normalized.Insert(key)
+		}
+	}
+	normalizeExpressions := func(expressions []corev1.NodeSelectorRequirement) {
+		for i := range expressions {
+			if expressions[i].Key == utils.ArchLabel {
+				return
+			}
+		}
+		for i := range expressions {
+			if equivalentKeys.Has(expressions[i].Key) {
+				normalized.Insert(expressions[i].Key)
+				expressions[i].Key = utils.ArchLabel
+			}
+		}
+	}
+	if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
+		nodeAffinity := pod.Spec.Affinity.NodeAffinity
+		if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			for i := range nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				normalizeExpressions(nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[i].MatchExpressions)
 			}
 		}
+		for i := range nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			normalizeExpressions(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[i].Preference.MatchExpressions)
+		}
+	}
+	if normalized.Len() == 0 {
+		return
+	}
+	ctrllog.FromContext(pod.Ctx()).V(2).Info("Normalized the architecture label keys", "keys", sets.List(normalized))
+	pod.EnsureAnnotation(utils.NormalizedArchitectureLabelsAnnotation, strings.Join(sets.List(normalized), ","))
+}
 
-		// If one of the NodeSelectorTerms does not have the architecture label, return false
-		if !hasArchLabel {
-			return false
+// isArchitectureConstrainedTerm returns true if the nodeSelectorTerm has a matchExpression for one of the given
+// architecture label keys, or a matchField selecting the nodes by name: the nodes selected by name have a fixed
+// architecture, chosen by the user.
+func isArchitectureConstrainedTerm(nodeSelectorTerm corev1.NodeSelectorTerm, archLabelKeys sets.Set[string]) bool {
+	// Check all match expressions within the current NodeSelectorTerm (expressions are ANDed)
+	for _, matchExpression := range nodeSelectorTerm.MatchExpressions {
+		if archLabelKeys.Has(matchExpression.Key) {
+			return true
 		}
+	}
+	return selectsNodesByName(nodeSelectorTerm)
+}
 
+// selectsNodesByName returns true if the nodeSelectorTerm has a matchField selecting the nodes by name.
+func selectsNodesByName(nodeSelectorTerm corev1.NodeSelectorTerm) bool {
+	for _, matchField := range nodeSelectorTerm.MatchFields {
+		if matchField.Key == metav1.ObjectNameField && matchField.Operator == corev1.NodeSelectorOpIn {
+			return true
+		}
 	}
+	return false
+}
 
-	// If all NodeSelectorTerms contain the architecture label, return true
-	return true
+// isOptedOut returns true if the pod has the multiarch.openshift.io/exclude-pod-placement label or annotation.
+// The pods with the label are usually filtered out by the objectSelector of the mutating webhook, but they can still
+// reach the controller when the label is added after the scheduling gate.
+func (pod *Pod) isOptedOut() bool {
+	_, hasLabel := pod.Labels[utils.PodOptOutLabel]
+	_, hasAnnotation := pod.Annotations[utils.PodOptOutLabel]
+	return hasLabel || hasAnnotation
 }
 
 func (pod *Pod) publishIgnorePod() {
@@ -513,17 +939,18 @@ func (pod *Pod) handleError(err error, s string) {
 }
 
 // isPreferredAffinityConfiguredForArchitecture returns true if the pod has a MatchExpression in the PreferredDuringSchedulingIgnoredDuringExecution
-// that matches kubernetes.io/arch
-func (pod *Pod) isPreferredAffinityConfiguredForArchitecture() bool {
+// that matches kubernetes.io/arch or any of the equivalent keys configured in the ClusterPodPlacementConfig
+func (pod *Pod) isPreferredAffinityConfiguredForArchitecture(cppc *v1beta1.ClusterPodPlacementConfig) bool {
 	if pod.Spec.Affinity == nil ||
 		pod.Spec.Affinity.NodeAffinity == nil ||
 		pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution == nil {
 		return false
 	}
 
+	archLabelKeys := cppc.ArchitectureLabelKeys()
 	for _, term := range pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
 		for _, expr := range term.Preference.MatchExpressions {
-			if expr.Key == utils.ArchLabel {
+			if archLabelKeys.Has(expr.Key) {
 				return true
 			}
 		}
@@ -537,14 +964,7 @@ func (pod *Pod) filterMatchingPPCs(ppcList *v1beta1.PodPlacementConfigList) []v1
 	var matching []v1beta1.PodPlacementConfig
 
 	for _, ppc := range ppcList.Items {
-		selector, err := metav1.LabelSelectorAsSelector(ppc.Spec.LabelSelector)
-		if err != nil {
-			// Invalid selector means it doesn't match - skip
-			continue
-		}
-
-		// Empty selector (Nothing()) or matching selector
-		if selector == labels.Nothing() || selector.Matches(labels.Set(pod.Labels)) {
+		if ppc.MatchesPodLabels(pod.Labels) {
 			matching = append(matching, ppc)
 		}
 	}
@@ -552,11 +972,12 @@ func (pod *Pod) filterMatchingPPCs(ppcList *v1beta1.PodPlacementConfigList) []v1
 	return matching
 }
 
-// hasMatchingPPCWithPlugin checks if any of the matching PPCs have the NodeAffinityScoring plugin enabled.
+// hasMatchingPPCWithPlugin checks if any of the matching PPCs have the NodeAffinityScoring or the CostAwareScoring
+// plugin enabled.
 // The matchingPPCs slice should already be filtered to only include PPCs whose label selector matches the pod.
 func (pod *Pod) hasMatchingPPCWithPlugin(matchingPPCs []v1beta1.PodPlacementConfig) bool {
 	for _, ppc := range matchingPPCs {
-		if ppc.PluginsEnabled(common.NodeAffinityScoringPluginName) {
+		if hasPreferredAffinityPlugin(ppc.PluginsEnabled) {
 			return true
 		}
 	}
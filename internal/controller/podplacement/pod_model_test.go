@@ -2,6 +2,7 @@ package podplacement
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 	"sort"
 	"strings"
@@ -286,6 +287,67 @@ func TestPod_intersectImagesArchitecture(t *testing.T) {
 	}
 }
 
+func TestPod_intersectImagesArchitecture_containerArchitectures(t *testing.T) {
+	g := NewGomegaWithT(t)
+	imageInspectionCache = fake.FacadeSingleton()
+	defer func() { imageInspectionCache = mmoimage.FacadeSingleton() }()
+	metrics.InitPodPlacementControllerMetrics()
+	pod := newPod(NewPod().WithContainersImages(fake.SingleArchAmd64Image).
+		WithInitContainersImages(fake.MultiArchImage).Build(), ctx, nil)
+	_, err := pod.intersectImagesArchitecture(nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	want := map[string][]string{
+		pod.Spec.Containers[0].Name:     {utils.ArchitectureAmd64},
+		pod.Spec.InitContainers[0].Name: {utils.ArchitectureAmd64, utils.ArchitectureArm64},
+	}
+	got := map[string][]string{}
+	g.Expect(json.Unmarshal([]byte(pod.Annotations[utils.ContainerArchitecturesAnnotation]), &got)).To(Succeed())
+	g.Expect(got).To(Equal(want))
+}
+
+func TestPod_incompatibleContainers(t *testing.T) {
+	tests := []struct {
+		name                    string
+		containersArchitectures map[string]sets.Set[string]
+		want                    []string
+	}{
+		{
+			name: "the containers not supporting the most supported architecture",
+			containersArchitectures: map[string]sets.Set[string]{
+				"app":     sets.New(utils.ArchitectureAmd64, utils.ArchitectureArm64),
+				"sidecar": sets.New(utils.ArchitectureArm64),
+				"proxy":   sets.New(utils.ArchitectureS390x),
+				"init":    sets.New(utils.ArchitecturePpc64le),
+			},
+			want: []string{"init", "proxy"},
+		},
+		{
+			name: "ties are broken by architecture name",
+			containersArchitectures: map[string]sets.Set[string]{
+				"app":     sets.New(utils.ArchitectureArm64),
+				"sidecar": sets.New(utils.ArchitectureAmd64),
+			},
+			want: []string{"app"},
+		},
+		{
+			name: "containers without supported architectures",
+			containersArchitectures: map[string]sets.Set[string]{
+				"app": sets.New[string](),
+			},
+			want: []string{"app"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := newPod(NewPod().Build(), ctx, nil)
+			pod.containersArchitectures = tt.containersArchitectures
+			if got := pod.incompatibleContainers(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("incompatibleContainers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestPod_getArchitecturePredicate(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -501,7 +563,7 @@ func TestPod_setArchNodeAffinity(t *testing.T) {
 			g := NewGomegaWithT(t)
 			pred, err := pod.getArchitecturePredicate(nil)
 			g.Expect(err).ShouldNot(HaveOccurred())
-			pod.setRequiredArchNodeAffinity(pred)
+			pod.setRequiredArchNodeAffinity(pred, nil)
 			g.Expect(pod.Spec.Affinity).Should(Equal(tt.want.Spec.Affinity))
 			imageInspectionCache = mmoimage.FacadeSingleton()
 		})
@@ -613,6 +675,198 @@ func TestPod_SetPreferredArchNodeAffinityPPC(t *testing.T) {
 	}
 }
 
+func TestPod_setPreferredArchNodeAffinityFromSources(t *testing.T) {
+	highPriorityPPC := NewPodPlacementConfig().
+		WithName("test-high-priority").
+		WithPriority(10).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 60).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 10).Build()
+	lowPriorityPPC := NewPodPlacementConfig().
+		WithName("test-low-priority").
+		WithPriority(5).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 50).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 30).Build()
+	cppc := NewClusterPodPlacementConfig().
+		WithName(common.SingletonResourceObjectName).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 20).
+		WithNodeAffinityScoringTerm(utils.ArchitecturePpc64le, 5).Build()
+	tests := []struct {
+		name              string
+		mergeStrategy     common.MergeStrategy
+		want              *v1.Pod
+		wantSourcesTokens []string
+	}{
+		{
+			name: "no merge strategy defaults to FirstMatchWins",
+			want: NewPod().WithPreferredDuringSchedulingIgnoredDuringExecution(
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureAmd64).WithWeight(60).Build(),
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureArm64).WithWeight(10).Build(),
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitecturePpc64le).WithWeight(5).Build(),
+			).Build(),
+			wantSourcesTokens: []string{
+				"amd64:60:PodPlacementConfig-test-high-priority",
+				"arm64:10:PodPlacementConfig-test-high-priority",
+				"amd64:50:PodPlacementConfig-test-low-priority:skipped",
+				"arm64:30:PodPlacementConfig-test-low-priority:skipped",
+				"arm64:20:ClusterPodPlacementConfig:skipped",
+				"ppc64le:5:ClusterPodPlacementConfig",
+			},
+		},
+		{
+			name:          "MergeByMaxWeight",
+			mergeStrategy: common.MergeStrategyMergeByMaxWeight,
+			want: NewPod().WithPreferredDuringSchedulingIgnoredDuringExecution(
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureAmd64).WithWeight(60).Build(),
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureArm64).WithWeight(30).Build(),
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitecturePpc64le).WithWeight(5).Build(),
+			).Build(),
+			wantSourcesTokens: []string{
+				"amd64:60:PodPlacementConfig-test-high-priority",
+				"arm64:10:PodPlacementConfig-test-high-priority:skipped",
+				"amd64:50:PodPlacementConfig-test-low-priority:skipped",
+				"arm64:30:PodPlacementConfig-test-low-priority",
+				"arm64:20:ClusterPodPlacementConfig:skipped",
+				"ppc64le:5:ClusterPodPlacementConfig",
+			},
+		},
+		{
+			name:          "Sum capped at 100",
+			mergeStrategy: common.MergeStrategySum,
+			want: NewPod().WithPreferredDuringSchedulingIgnoredDuringExecution(
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureAmd64).WithWeight(100).Build(),
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureArm64).WithWeight(60).Build(),
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitecturePpc64le).WithWeight(5).Build(),
+			).Build(),
+			wantSourcesTokens: []string{
+				"amd64:60:PodPlacementConfig-test-high-priority",
+				"arm64:10:PodPlacementConfig-test-high-priority",
+				"amd64:50:PodPlacementConfig-test-low-priority",
+				"arm64:30:PodPlacementConfig-test-low-priority",
+				"arm64:20:ClusterPodPlacementConfig",
+				"ppc64le:5:ClusterPodPlacementConfig",
+			},
+		},
+		{
+			name:          "Override",
+			mergeStrategy: common.MergeStrategyOverride,
+			want: NewPod().WithPreferredDuringSchedulingIgnoredDuringExecution(
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureAmd64).WithWeight(60).Build(),
+				NewPreferredSchedulingTerm().WithArchitecture(utils.ArchitectureArm64).WithWeight(10).Build(),
+			).Build(),
+			wantSourcesTokens: []string{
+				"amd64:60:PodPlacementConfig-test-high-priority",
+				"arm64:10:PodPlacementConfig-test-high-priority",
+				"amd64:50:PodPlacementConfig-test-low-priority:skipped",
+				"arm64:30:PodPlacementConfig-test-low-priority:skipped",
+				"arm64:20:ClusterPodPlacementConfig:skipped",
+				"ppc64le:5:ClusterPodPlacementConfig:skipped",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			pod := newPod(NewPod().Build(), ctx, nil)
+			highPriorityPPC.Spec.MergeStrategy = tt.mergeStrategy
+			// The merge strategy of the lower priority PodPlacementConfigs is ignored
+			lowPriorityPPC.Spec.MergeStrategy = common.MergeStrategyOverride
+			sources, strategy := nodeAffinityScoringSources(cppc, []v1beta1.PodPlacementConfig{*lowPriorityPPC, *highPriorityPPC})
+			pod.setPreferredArchNodeAffinityFromSources(sources, strategy)
+			g.Expect(pod.Spec.Affinity).Should(Equal(tt.want.Spec.Affinity))
+			g.Expect(pod.Labels).Should(HaveKeyWithValue(utils.PreferredNodeAffinityLabel, utils.NodeAffinityLabelValueSet))
+			g.Expect(strings.Split(pod.Annotations[utils.PreferredNodeAffinitySourcesAnnotation], ",")).Should(
+				ConsistOf(tt.wantSourcesTokens))
+		})
+	}
+}
+
+func Test_nodeAffinityScoringSources(t *testing.T) {
+	g := NewGomegaWithT(t)
+	disabledPPC := NewPodPlacementConfig().
+		WithName("test-disabled").
+		WithPriority(20).
+		WithMergeStrategy(common.MergeStrategyOverride).
+		WithNodeAffinityScoring(false).Build()
+	ppc := NewPodPlacementConfig().
+		WithName("test-enabled").
+		WithPriority(10).
+		WithMergeStrategy(common.MergeStrategySum).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 50).Build()
+	cppc := NewClusterPodPlacementConfig().
+		WithName(common.SingletonResourceObjectName).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 20).Build()
+
+	sources, strategy := nodeAffinityScoringSources(cppc, []v1beta1.PodPlacementConfig{*ppc, *disabledPPC})
+	g.Expect(strategy).To(Equal(common.MergeStrategySum), "the strategy of the disabled PodPlacementConfig should be ignored")
+	g.Expect(sources).To(HaveLen(2))
+	g.Expect(sources[0].name).To(Equal(ppc.AffinitySource()))
+	g.Expect(sources[1].name).To(Equal(v1beta1.ClusterPodPlacementConfigKind))
+
+	sources, strategy = nodeAffinityScoringSources(nil, []v1beta1.PodPlacementConfig{*disabledPPC})
+	g.Expect(strategy).To(Equal(common.MergeStrategyFirstMatchWins))
+	g.Expect(sources).To(BeEmpty())
+}
+
+func Test_predictsPreferredNodeAffinity(t *testing.T) {
+	staticPPC := NewPodPlacementConfig().
+		WithName("test-static").
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 50).Build()
+	dynamicPPC := NewPodPlacementConfig().
+		WithName("test-dynamic").
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 50).
+		WithNodeAffinityScoringMode(plugins.NodeAffinityScoringModeDynamic).Build()
+	costAwarePPC := NewPodPlacementConfig().
+		WithName("test-cost-aware").
+		WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 50).Build()
+	disabledPPC := NewPodPlacementConfig().
+		WithName("test-disabled").
+		WithNodeAffinityScoring(false).
+		WithNodeAffinityScoringMode(plugins.NodeAffinityScoringModeDynamic).Build()
+	staticCPPC := NewClusterPodPlacementConfig().
+		WithName(common.SingletonResourceObjectName).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 20).Build()
+	dynamicCPPC := NewClusterPodPlacementConfig().
+		WithName(common.SingletonResourceObjectName).
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 20).
+		WithNodeAffinityScoringMode(plugins.NodeAffinityScoringModeDynamic).Build()
+	costAwareCPPC := NewClusterPodPlacementConfig().
+		WithName(common.SingletonResourceObjectName).
+		WithCostAwareScoring(true, plugins.CostHintsSourceConfigMap, 50).Build()
+	tests := []struct {
+		name         string
+		cppc         *v1beta1.ClusterPodPlacementConfig
+		matchingPPCs []v1beta1.PodPlacementConfig
+		want         bool
+	}{
+		{name: "no source", want: false},
+		{name: "disabled PPC only", matchingPPCs: []v1beta1.PodPlacementConfig{*disabledPPC}, want: false},
+		{name: "static CPPC", cppc: staticCPPC, want: true},
+		{name: "static PPC", matchingPPCs: []v1beta1.PodPlacementConfig{*staticPPC, *disabledPPC}, want: true},
+		{name: "dynamic CPPC", cppc: dynamicCPPC, want: false},
+		{name: "cost-aware CPPC", cppc: costAwareCPPC, want: false},
+		{name: "static PPC and dynamic CPPC", cppc: dynamicCPPC,
+			matchingPPCs: []v1beta1.PodPlacementConfig{*staticPPC}, want: false},
+		{name: "dynamic PPC and static CPPC", cppc: staticCPPC,
+			matchingPPCs: []v1beta1.PodPlacementConfig{*dynamicPPC}, want: false},
+		{name: "cost-aware PPC", matchingPPCs: []v1beta1.PodPlacementConfig{*staticPPC, *costAwarePPC}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(predictsPreferredNodeAffinity(tt.cppc, tt.matchingPPCs)).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestPod_SetPreferredArchNodeAffinity(t *testing.T) {
 	tests := []struct {
 		name string
@@ -652,6 +906,63 @@ func TestPod_SetPreferredArchNodeAffinity(t *testing.T) {
 	}
 }
 
+func TestPod_checkCompatibleNodes(t *testing.T) {
+	requirement := v1.NodeSelectorRequirement{
+		Key:      utils.ArchLabel,
+		Operator: v1.NodeSelectorOpIn,
+		Values:   []string{utils.ArchitectureS390x},
+	}
+	tests := []struct {
+		name              string
+		pod               *v1.Pod
+		nodeArchitectures sets.Set[string]
+		want              bool
+		wantEvent         bool
+	}{
+		{
+			name:              "node architectures not known yet",
+			pod:               NewPod().Build(),
+			nodeArchitectures: nil,
+			want:              false,
+		},
+		{
+			name:              "compatible nodes available",
+			pod:               NewPod().WithLabels(utils.NoCompatibleNodesLabel, "").Build(),
+			nodeArchitectures: sets.New(utils.ArchitectureAmd64, utils.ArchitectureS390x),
+			want:              false,
+		},
+		{
+			name:              "no compatible nodes",
+			pod:               NewPod().Build(),
+			nodeArchitectures: sets.New(utils.ArchitectureAmd64, utils.ArchitectureArm64),
+			want:              true,
+			wantEvent:         true,
+		},
+		{
+			name:              "no compatible nodes already reported",
+			pod:               NewPod().WithLabels(utils.NoCompatibleNodesLabel, "").Build(),
+			nodeArchitectures: sets.New(utils.ArchitectureAmd64, utils.ArchitectureArm64),
+			want:              true,
+			wantEvent:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedulableArchitectures.store(tt.nodeArchitectures)
+			defer schedulableArchitectures.store(nil)
+			recorder := record.NewFakeRecorder(1)
+			pod := newPod(tt.pod, ctx, recorder)
+			pod.checkCompatibleNodes(requirement)
+			if got := pod.hasNoCompatibleNodes(); got != tt.want {
+				t.Errorf("hasNoCompatibleNodes() = %v, want %v", got, tt.want)
+			}
+			if got := len(recorder.Events) > 0; got != tt.wantEvent {
+				t.Errorf("checkCompatibleNodes() published an event = %v, want %v", got, tt.wantEvent)
+			}
+		})
+	}
+}
+
 func TestPod_setRequiredNodeAffinityToFallbackArchitecture(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -677,7 +988,7 @@ func TestPod_setRequiredNodeAffinityToFallbackArchitecture(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			pod := newPod(tt.pod, ctx, nil)
-			pod.setRequiredNodeAffinityToFallbackArchitecture(tt.architecture)
+			pod.setRequiredNodeAffinityToFallbackArchitecture(tt.architecture, nil)
 			g := NewGomegaWithT(t)
 			g.Expect(pod.Spec.Affinity).Should(Equal(tt.want.Spec.Affinity))
 		})
@@ -868,7 +1179,7 @@ func TestPod_SetNodeAffinityArchRequirement(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			imageInspectionCache = fake.FacadeSingleton()
 			pod := newPod(tt.pod, ctx, nil)
-			_, err := pod.SetNodeAffinityArchRequirement(tt.pullSecretDataList)
+			_, err := pod.SetNodeAffinityArchRequirement(tt.pullSecretDataList, nil)
 			g := NewGomegaWithT(t)
 			if tt.expectErr {
 				g.Expect(err).Should(HaveOccurred())
@@ -881,6 +1192,101 @@ func TestPod_SetNodeAffinityArchRequirement(t *testing.T) {
 	}
 }
 
+func TestPod_SetNodeAffinityArchRequirement_intersect(t *testing.T) {
+	requirement := v1.NodeSelectorRequirement{
+		Key:      utils.ArchLabel,
+		Operator: v1.NodeSelectorOpIn,
+		Values:   []string{utils.ArchitectureAmd64, utils.ArchitectureArm64},
+	}
+	archIn := func(values ...string) v1.NodeSelectorRequirement {
+		return v1.NodeSelectorRequirement{Key: utils.ArchLabel, Operator: v1.NodeSelectorOpIn, Values: values}
+	}
+	nodeName := v1.NodeSelectorRequirement{Key: "metadata.name", Operator: v1.NodeSelectorOpIn, Values: []string{"worker-0"}}
+	tests := []struct {
+		name              string
+		cppc              *v1beta1.ClusterPodPlacementConfig
+		pod               *v1.Pod
+		wantTerms         []v1.NodeSelectorTerm
+		wantUnsupportedEv bool
+	}{
+		{
+			name: "skip policy leaves the user's constraints unchanged",
+			cppc: NewClusterPodPlacementConfig().Build(),
+			pod: NewPod().WithContainersImages(fake.MultiArchImage).WithNodeSelectorTermsMatchExpressions(
+				[]v1.NodeSelectorRequirement{archIn(utils.ArchitectureS390x)}).Build(),
+			wantTerms: []v1.NodeSelectorTerm{{MatchExpressions: []v1.NodeSelectorRequirement{archIn(utils.ArchitectureS390x)}}},
+		},
+		{
+			name: "user's values are narrowed to the supported ones",
+			cppc: NewClusterPodPlacementConfig().WithArchitectureConstraintsPolicy(common.ArchitectureConstraintsPolicyIntersect).Build(),
+			pod: NewPod().WithContainersImages(fake.MultiArchImage).WithNodeSelectorTermsMatchExpressions(
+				[]v1.NodeSelectorRequirement{archIn(utils.ArchitectureS390x, utils.ArchitectureArm64)},
+				[]v1.NodeSelectorRequirement{{Key: "foo", Operator: v1.NodeSelectorOpIn, Values: []string{"bar"}}}).Build(),
+			wantTerms: []v1.NodeSelectorTerm{
+				{MatchExpressions: []v1.NodeSelectorRequirement{archIn(utils.ArchitectureArm64)}},
+				{MatchExpressions: []v1.NodeSelectorRequirement{{Key: "foo", Operator: v1.NodeSelectorOpIn, Values: []string{"bar"}}, requirement}},
+			},
+			wantUnsupportedEv: true,
+		},
+		{
+			name: "the requirement is added when no user's value is supported or the operator is not In",
+			cppc: NewClusterPodPlacementConfig().WithArchitectureConstraintsPolicy(common.ArchitectureConstraintsPolicyIntersect).Build(),
+			pod: NewPod().WithContainersImages(fake.MultiArchImage).WithNodeSelectorTermsMatchExpressions(
+				[]v1.NodeSelectorRequirement{archIn(utils.ArchitectureS390x)},
+				[]v1.NodeSelectorRequirement{{Key: utils.ArchLabel, Operator: v1.NodeSelectorOpNotIn, Values: []string{utils.ArchitectureAmd64}}}).Build(),
+			wantTerms: []v1.NodeSelectorTerm{
+				{MatchExpressions: []v1.NodeSelectorRequirement{archIn(utils.ArchitectureS390x), requirement}},
+				{MatchExpressions: []v1.NodeSelectorRequirement{{Key: utils.ArchLabel, Operator: v1.NodeSelectorOpNotIn, Values: []string{utils.ArchitectureAmd64}}, requirement}},
+			},
+			wantUnsupportedEv: true,
+		},
+		{
+			name: "the requirement is added when the nodeSelector constrains the architecture",
+			cppc: NewClusterPodPlacementConfig().WithArchitectureConstraintsPolicy(common.ArchitectureConstraintsPolicyIntersect).Build(),
+			pod: NewPod().WithContainersImages(fake.MultiArchImage).WithNodeSelectors(
+				utils.ArchLabel, utils.ArchitecturePpc64le).Build(),
+			wantTerms:         []v1.NodeSelectorTerm{{MatchExpressions: []v1.NodeSelectorRequirement{requirement}}},
+			wantUnsupportedEv: true,
+		},
+		{
+			name: "the terms selecting the nodes by name are unchanged",
+			cppc: NewClusterPodPlacementConfig().WithArchitectureConstraintsPolicy(common.ArchitectureConstraintsPolicyIntersect).Build(),
+			pod: NewPod().WithContainersImages(fake.MultiArchImage).WithAffinity(&v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{{MatchFields: []v1.NodeSelectorRequirement{nodeName}}},
+					},
+				},
+			}).Build(),
+			wantTerms: []v1.NodeSelectorTerm{{MatchFields: []v1.NodeSelectorRequirement{nodeName}}},
+		},
+	}
+	metrics.InitPodPlacementControllerMetrics()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			imageInspectionCache = fake.FacadeSingleton()
+			defer func() { imageInspectionCache = mmoimage.FacadeSingleton() }()
+			recorder := record.NewFakeRecorder(10)
+			pod := newPod(tt.pod, ctx, recorder)
+			_, err := pod.SetNodeAffinityArchRequirement(nil, tt.cppc)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).
+				To(Equal(tt.wantTerms))
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if tt.wantUnsupportedEv {
+				g.Expect(events).To(ContainElement(ContainSubstring(UnsupportedUserArchitectures)))
+			} else {
+				g.Expect(events).NotTo(ContainElement(ContainSubstring(UnsupportedUserArchitectures)))
+			}
+		})
+	}
+}
+
 // TestEnsureArchitectureLabels checks the ensureArchitectureLabels method to ensure it sets the correct labels based on NodeSelectorRequirement.
 func TestEnsureArchitectureLabels(t *testing.T) {
 	tests := []struct {
@@ -1055,6 +1461,20 @@ func TestPod_shouldIgnorePod(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "pod with the opt-out label",
+			fields: fields{
+				Pod: NewPod().WithLabels(utils.PodOptOutLabel, "").Build(),
+			},
+			want: true,
+		},
+		{
+			name: "pod with the opt-out annotation",
+			fields: fields{
+				Pod: NewPod().WithAnnotations(map[string]string{utils.PodOptOutLabel: "true"}).Build(),
+			},
+			want: true,
+		},
 		{
 			name: "pod with nodeName set",
 			fields: fields{
@@ -1124,6 +1544,71 @@ func TestPod_shouldIgnorePod(t *testing.T) {
 	}
 }
 
+func TestPod_shouldIgnorePodInIgnoredNamespaces(t *testing.T) {
+	cppc := NewClusterPodPlacementConfig().WithIgnoredNamespaces("istio-system", "openshift-*").Build()
+	tests := []struct {
+		namespace string
+		want      bool
+	}{
+		{namespace: "istio-system", want: true},
+		{namespace: "openshift-monitoring", want: true},
+		{namespace: "test-namespace", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.namespace, func(t *testing.T) {
+			pod := newPod(NewPod().WithNamespace(tt.namespace).Build(), ctx, nil)
+			if got := pod.shouldIgnorePod(cppc, []v1beta1.PodPlacementConfig{}); got != tt.want {
+				t.Errorf("shouldIgnorePod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPod_isNamespaceIgnored(t *testing.T) {
+	cppc := NewClusterPodPlacementConfig().WithIgnoredNamespaces("openshift-*").Build()
+	tests := []struct {
+		name      string
+		namespace string
+		cppc      *v1beta1.ClusterPodPlacementConfig
+		want      bool
+	}{
+		{name: "operator namespace", namespace: utils.Namespace(), cppc: cppc, want: true},
+		{name: "kube-* namespace", namespace: "kube-system", cppc: cppc, want: true},
+		{name: "ignored namespace", namespace: "openshift-monitoring", cppc: cppc, want: true},
+		{name: "ignored namespace with no ClusterPodPlacementConfig", namespace: "openshift-monitoring", want: false},
+		{name: "other namespace", namespace: "test-namespace", cppc: cppc, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			pod := newPod(NewPod().WithNamespace(tt.namespace).Build(), ctx, nil)
+			g.Expect(pod.isNamespaceIgnored(tt.cppc)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestPod_shouldIgnorePodHasNoSideEffects(t *testing.T) {
+	g := NewGomegaWithT(t)
+	recorder := record.NewFakeRecorder(1)
+	pod := newPod(NewPod().WithAnnotations(map[string]string{utils.PodOptOutLabel: ""}).Build(), ctx, recorder)
+	g.Expect(pod.shouldIgnorePod(&v1beta1.ClusterPodPlacementConfig{}, []v1beta1.PodPlacementConfig{})).To(BeTrue())
+	g.Expect(recorder.Events).NotTo(Receive(), "the predicate is also evaluated by the webhook at admission time")
+}
+
+func TestPod_shouldIgnorePodWithIntersectArchitectureConstraints(t *testing.T) {
+	pod := newPod(NewPod().WithContainersImages(fake.MultiArchImage).WithNodeSelectors(
+		utils.ArchLabel, utils.ArchitectureAmd64).Build(), ctx, nil)
+	skip := NewClusterPodPlacementConfig().Build()
+	if !pod.shouldIgnorePod(skip, []v1beta1.PodPlacementConfig{}) {
+		t.Errorf("shouldIgnorePod() = false, want true with the Skip policy")
+	}
+	intersect := NewClusterPodPlacementConfig().
+		WithArchitectureConstraintsPolicy(common.ArchitectureConstraintsPolicyIntersect).Build()
+	if pod.shouldIgnorePod(intersect, []v1beta1.PodPlacementConfig{}) {
+		t.Errorf("shouldIgnorePod() = true, want false with the Intersect policy")
+	}
+}
+
 func TestPod_shouldIgnorePodWithPluginsEnabledInCPPC(t *testing.T) {
 	type fields struct {
 		Pod      *v1.Pod
@@ -1295,7 +1780,7 @@ func TestIsPreferredAffinityConfiguredForArchitecture(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			pod := newPod(NewPod().WithAffinity(test.affinity).Build(), ctx, nil)
-			result := pod.isPreferredAffinityConfiguredForArchitecture()
+			result := pod.isPreferredAffinityConfiguredForArchitecture(nil)
 			if result != test.expected {
 				t.Errorf("expected %v, got %v", test.expected, result)
 			}
@@ -1308,6 +1793,7 @@ func TestIsNodeSelectorConfiguredForArchitecture(t *testing.T) {
 		name         string
 		nodeSelector map[string]string
 		affinity     *v1.Affinity
+		cppc         *v1beta1.ClusterPodPlacementConfig
 		expected     bool
 	}{
 		{
@@ -1393,6 +1879,57 @@ func TestIsNodeSelectorConfiguredForArchitecture(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name:         "Has NodeSelector for an equivalent Architecture Label",
+			nodeSelector: map[string]string{"beta.kubernetes.io/arch": utils.ArchitectureAmd64},
+			cppc:         NewClusterPodPlacementConfig().WithArchitectureLabels(false, "beta.kubernetes.io/arch").Build(),
+			expected:     true,
+		},
+		{
+			name:         "Has NodeSelector for an Architecture Label not configured as equivalent",
+			nodeSelector: map[string]string{"beta.kubernetes.io/arch": utils.ArchitectureAmd64},
+			expected:     false,
+		},
+		{
+			name: "No NodeSelector, NodeSelectorTerms with an equivalent Architecture Label or matchFields on the node name",
+			affinity: &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{
+							{
+								MatchExpressions: []v1.NodeSelectorRequirement{
+									{Key: "example.com/arch", Operator: v1.NodeSelectorOpIn, Values: []string{utils.ArchitectureArm64}},
+								},
+							},
+							{
+								MatchFields: []v1.NodeSelectorRequirement{
+									{Key: "metadata.name", Operator: v1.NodeSelectorOpIn, Values: []string{"worker-0"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			cppc:     NewClusterPodPlacementConfig().WithArchitectureLabels(false, "example.com/arch").Build(),
+			expected: true,
+		},
+		{
+			name: "No NodeSelector, NodeSelectorTerm with matchFields excluding a node name",
+			affinity: &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{
+							{
+								MatchFields: []v1.NodeSelectorRequirement{
+									{Key: "metadata.name", Operator: v1.NodeSelectorOpNotIn, Values: []string{"worker-0"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -1403,7 +1940,7 @@ func TestIsNodeSelectorConfiguredForArchitecture(t *testing.T) {
 			}
 			pod := newPod(NewPod().WithNodeSelectors(nodeSelectors...).WithAffinity(test.affinity).Build(), ctx, nil)
 
-			result := pod.isNodeSelectorConfiguredForArchitecture()
+			result := pod.isNodeSelectorConfiguredForArchitecture(test.cppc)
 			if result != test.expected {
 				t.Errorf("expected %v, got %v", test.expected, result)
 			}
@@ -1411,6 +1948,112 @@ func TestIsNodeSelectorConfiguredForArchitecture(t *testing.T) {
 	}
 }
 
+func newArchAffinity(key, architecture string) *v1.Affinity {
+	return &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					*NewNodeSelectorTerm().WithMatchExpressions(
+						NewNodeSelectorRequirement().WithKeyAndValues(key, v1.NodeSelectorOpIn, architecture).Build(),
+					).Build(),
+				},
+			},
+			PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{
+				*NewPreferredSchedulingTerm().WithCustomKeyValue(key, architecture).WithWeight(10).Build(),
+			},
+		},
+	}
+}
+
+func TestPod_normalizeArchitectureLabels(t *testing.T) {
+	cppc := NewClusterPodPlacementConfig().WithArchitectureLabels(true, "beta.kubernetes.io/arch", "example.com/arch").Build()
+	tests := []struct {
+		name               string
+		nodeSelector       map[string]string
+		affinity           *v1.Affinity
+		expectNodeSelector map[string]string
+		expectAffinity     *v1.Affinity
+		expectAnnotation   string
+	}{
+		{
+			name:               "nodeSelector on an equivalent key is rewritten",
+			nodeSelector:       map[string]string{"beta.kubernetes.io/arch": utils.ArchitectureArm64, "foo": "bar"},
+			expectNodeSelector: map[string]string{utils.ArchLabel: utils.ArchitectureArm64, "foo": "bar"},
+			expectAnnotation:   "beta.kubernetes.io/arch",
+		},
+		{
+			name: "nodeSelector on an equivalent key is kept when kubernetes.io/arch is set",
+			nodeSelector: map[string]string{"beta.kubernetes.io/arch": utils.ArchitectureArm64,
+				utils.ArchLabel: utils.ArchitectureAmd64},
+			expectNodeSelector: map[string]string{"beta.kubernetes.io/arch": utils.ArchitectureArm64,
+				utils.ArchLabel: utils.ArchitectureAmd64},
+		},
+		{
+			name: "nodeSelector on all the equivalent keys is rewritten",
+			nodeSelector: map[string]string{"beta.kubernetes.io/arch": utils.ArchitectureArm64,
+				"example.com/arch": utils.ArchitectureArm64},
+			expectNodeSelector: map[string]string{utils.ArchLabel: utils.ArchitectureArm64},
+			expectAnnotation:   "beta.kubernetes.io/arch,example.com/arch",
+		},
+		{
+			name: "nodeSelector on an equivalent key with a conflicting value is kept",
+			nodeSelector: map[string]string{"beta.kubernetes.io/arch": utils.ArchitectureArm64,
+				"example.com/arch": utils.ArchitectureAmd64},
+			expectNodeSelector: map[string]string{utils.ArchLabel: utils.ArchitectureArm64,
+				"example.com/arch": utils.ArchitectureAmd64},
+			expectAnnotation: "beta.kubernetes.io/arch",
+		},
+		{
+			name:             "node affinity expressions on an equivalent key are rewritten",
+			affinity:         newArchAffinity("beta.kubernetes.io/arch", utils.ArchitectureS390x),
+			expectAffinity:   newArchAffinity(utils.ArchLabel, utils.ArchitectureS390x),
+			expectAnnotation: "beta.kubernetes.io/arch",
+		},
+		{
+			name: "node affinity expressions on all the equivalent keys are rewritten",
+			affinity: &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+					NodeSelectorTerms: []v1.NodeSelectorTerm{*NewNodeSelectorTerm().WithMatchExpressions(
+						NewNodeSelectorRequirement().WithKeyAndValues("beta.kubernetes.io/arch", v1.NodeSelectorOpIn,
+							utils.ArchitectureS390x).Build(),
+						NewNodeSelectorRequirement().WithKeyAndValues("example.com/arch", v1.NodeSelectorOpIn,
+							utils.ArchitectureS390x).Build(),
+					).Build()},
+				},
+			}},
+			expectAffinity: &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+					NodeSelectorTerms: []v1.NodeSelectorTerm{*NewNodeSelectorTerm().WithMatchExpressions(
+						NewNodeSelectorRequirement().WithKeyAndValues(utils.ArchLabel, v1.NodeSelectorOpIn,
+							utils.ArchitectureS390x).Build(),
+						NewNodeSelectorRequirement().WithKeyAndValues(utils.ArchLabel, v1.NodeSelectorOpIn,
+							utils.ArchitectureS390x).Build(),
+					).Build()},
+				},
+			}},
+			expectAnnotation: "beta.kubernetes.io/arch,example.com/arch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			var nodeSelectors []string
+			for k, v := range tt.nodeSelector {
+				nodeSelectors = append(nodeSelectors, k, v)
+			}
+			pod := newPod(NewPod().WithNodeSelectors(nodeSelectors...).WithAffinity(tt.affinity).Build(), ctx, nil)
+			pod.normalizeArchitectureLabels(cppc)
+			if tt.expectNodeSelector != nil {
+				g.Expect(pod.Spec.NodeSelector).To(Equal(tt.expectNodeSelector))
+			}
+			if tt.expectAffinity != nil {
+				g.Expect(pod.Spec.Affinity).To(Equal(tt.expectAffinity))
+			}
+			g.Expect(pod.Annotations[utils.NormalizedArchitectureLabelsAnnotation]).To(Equal(tt.expectAnnotation))
+		})
+	}
+}
+
 func TestPod_filterMatchingPPCs(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	runtime2 "runtime"
-	"sort"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -52,6 +51,10 @@ type PodReconciler struct {
 	Recorder  record.EventRecorder
 }
 
+// noCompatibleNodesRequeuePeriod is the period at which the pods kept gated by the KeepGated NoCompatibleNodesPolicy
+// are processed again.
+const noCompatibleNodesRequeuePeriod = time.Minute
+
 // Note: The operand (pod-placement-controller) RBAC is defined programmatically in
 // podplacement_objects.go buildClusterRoleController(). Do NOT add operand-specific
 // RBAC markers here — kubebuilder merges all markers into the manager's ClusterRole,
@@ -97,7 +100,9 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	if !pod.HasSchedulingGate() {
 		// Only publish the event if the scheduling gate has been removed and the pod has been updated successfully.
 		pod.PublishEvent(corev1.EventTypeNormal, ArchitectureAwareSchedulingGateRemovalSuccess, SchedulingGateRemovalSuccessMsg)
-		metrics.GatedPodsGauge.Dec()
+	} else if pod.hasNoCompatibleNodes() {
+		// The pod is kept gated until a compatible node joins the cluster: the nodes are not watched by this controller.
+		return ctrl.Result{RequeueAfter: noCompatibleNodesRequeuePeriod}, nil
 	}
 	return ctrl.Result{}, nil
 }
@@ -107,24 +112,11 @@ func (r *PodReconciler) processPod(ctx context.Context, pod *Pod) {
 	log.V(1).Info("Processing pod")
 
 	cppc := clusterpodplacementconfig.GetClusterPodPlacementConfig()
-	// List existing PodPlacementConfigs in the same namespace
-	ppcList := &multiarchv1beta1.PodPlacementConfigList{}
-	if err := r.List(ctx, ppcList, client.InNamespace(pod.Namespace)); err != nil {
+	matchingPPCs, err := r.listMatchingPPCs(ctx, pod)
+	if err != nil {
 		pod.handleError(err, "failed to list existing PodPlacementConfigs in namespace")
 		return
 	}
-	// The informer cache can lag behind the API server. If the cache shows no PPCs,
-	// verify with a direct API read to avoid a race where a just-created PPC is missed
-	// and the pod is permanently ungated without its preferred affinity.
-	if len(ppcList.Items) == 0 {
-		if err := r.APIReader.List(ctx, ppcList, client.InNamespace(pod.Namespace)); err != nil {
-			pod.handleError(err, "failed to list PodPlacementConfigs from API server")
-			return
-		}
-	}
-
-	// Filter to only PPCs that match this pod's labels - do this once for efficiency
-	matchingPPCs := pod.filterMatchingPPCs(ppcList)
 
 	if pod.shouldIgnorePod(cppc, matchingPPCs) {
 		log.V(3).Info("A pod with the scheduling gate should be ignored. Ignoring...")
@@ -135,18 +127,50 @@ func (r *PodReconciler) processPod(ctx context.Context, pod *Pod) {
 		// In both cases, we should just remove the scheduling gate.
 		log.V(1).Info("Removing the scheduling gate from pod.")
 		pod.RemoveSchedulingGate()
+		if pod.isOptedOut() {
+			// The event is published here rather than in shouldIgnorePod, that the webhook also calls at admission
+			// time, before the pod is persisted.
+			log.V(1).Info("The pod opted out of the architecture-aware scheduling.")
+			pod.PublishEvent(corev1.EventTypeNormal, ArchitectureAwarePodOptedOut, PodOptedOutMsg)
+			return
+		}
 		pod.PublishEvent(corev1.EventTypeWarning, ArchitectureAwareGatedPodIgnored, ArchitectureAwareGatedPodIgnoredMsg)
 		return
 	}
+	r.setPlacement(ctx, pod, cppc, matchingPPCs)
+}
+
+// listMatchingPPCs lists the PodPlacementConfigs in the pod's namespace and returns the ones matching the pod.
+func (r *PodReconciler) listMatchingPPCs(ctx context.Context, pod *Pod) ([]multiarchv1beta1.PodPlacementConfig, error) {
+	// List existing PodPlacementConfigs in the same namespace
+	ppcList := &multiarchv1beta1.PodPlacementConfigList{}
+	if err := r.List(ctx, ppcList, client.InNamespace(pod.Namespace)); err != nil {
+		return nil, err
+	}
+	// The informer cache can lag behind the API server. If the cache shows no PPCs,
+	// verify with a direct API read to avoid a race where a just-created PPC is missed
+	// and the pod is permanently ungated without its preferred affinity.
+	if len(ppcList.Items) == 0 {
+		if err := r.APIReader.List(ctx, ppcList, client.InNamespace(pod.Namespace)); err != nil {
+			return nil, err
+		}
+	}
 
+	// Filter to only PPCs that match this pod's labels - do this once for efficiency
+	return pod.filterMatchingPPCs(ppcList), nil
+}
+
+// setPlacement sets the preferred and required node affinity of a pod that should not be ignored and removes the
+// scheduling gate once the pod has been processed or the max retries have been reached.
+func (r *PodReconciler) setPlacement(ctx context.Context, pod *Pod, cppc *multiarchv1beta1.ClusterPodPlacementConfig,
+	matchingPPCs []multiarchv1beta1.PodPlacementConfig) {
+	log := ctrllog.FromContext(ctx)
+	// original is restored if the pod has to stay gated until a compatible node joins the cluster
+	original := pod.PodObject().DeepCopy()
 	// Skip preferred affinity processing if the user has already configured architecture-related preferred affinity
 	// or if the reconcile loop has already applied the PPCs/CPPC (e.g., due to a retry or re-reconciliation)
-	if !pod.isPreferredAffinityConfiguredForArchitecture() {
-		r.applyMatchingPPCs(ctx, matchingPPCs, pod)
-
-		if cppc != nil && cppc.PluginsEnabled(common.NodeAffinityScoringPluginName) {
-			pod.SetPreferredArchNodeAffinity(cppc.Spec.Plugins.NodeAffinityScoring, multiarchv1beta1.ClusterPodPlacementConfigKind)
-		}
+	if !pod.isPreferredAffinityConfiguredForArchitecture(cppc) {
+		r.applyMatchingPPCs(ctx, cppc, matchingPPCs, pod)
 	} else {
 		log.V(2).Info("Pod already has architecture-related preferred affinity. This could be user-defined or from a previous reconcile loop. Skipping PPC/CPPC preferred affinity processing.")
 		// Track that configs were skipped due to user-defined preferences
@@ -154,12 +178,27 @@ func (r *PodReconciler) processPod(ctx context.Context, pod *Pod) {
 	}
 
 	// Prepare the requirement for the node affinity.
-	psdl, err := r.pullSecretDataList(ctx, pod)
+	psdl, err := pullSecretDataList(ctx, r.ClientSet, pod)
 	pod.handleError(err, "Unable to retrieve the image pull secret data for the pod.")
 	// If no error occurred when retrieving the image pull secret data, set the node affinity.
 	if err == nil {
-		_, err = pod.SetNodeAffinityArchRequirement(psdl)
+		specBeforeRequirement := pod.Spec.DeepCopy()
+		_, err = pod.SetNodeAffinityArchRequirement(psdl, cppc)
 		pod.handleError(err, "Unable to set the node affinity for the pod.")
+		if err == nil && pod.hasNoCompatibleNodes() {
+			switch cppc.GetNoCompatibleNodesPolicy() {
+			case common.NoCompatibleNodesPolicyKeepGated:
+				log.Info("No node can run the pod. Keeping the scheduling gate until a compatible node joins the cluster.")
+				*pod.PodObject() = *original
+				pod.EnsureLabel(utils.NoCompatibleNodesLabel, "")
+				return
+			case common.NoCompatibleNodesPolicyFallback:
+				log.Info("No node can run the pod. Setting the nodeAffinity to the fallback architecture",
+					"fallbackArchitecture", cppc.Spec.FallbackArchitecture)
+				pod.Spec = *specBeforeRequirement
+				pod.setRequiredNodeAffinityToFallbackArchitecture(cppc.Spec.FallbackArchitecture, cppc)
+			}
+		}
 	}
 	if pod.maxRetries() && err != nil {
 		// the number of retries is incremented in the handleError function when the error is not nil.
@@ -172,7 +211,7 @@ func (r *PodReconciler) processPod(ctx context.Context, pod *Pod) {
 
 		if cppc != nil && cppc.Spec.FallbackArchitecture != "" {
 			log.Info("Setting the nodeAffinity to the fallback architecture", "fallbackArchitecture", cppc.Spec.FallbackArchitecture)
-			pod.setRequiredNodeAffinityToFallbackArchitecture(cppc.Spec.FallbackArchitecture)
+			pod.setRequiredNodeAffinityToFallbackArchitecture(cppc.Spec.FallbackArchitecture, cppc)
 		}
 	}
 	// If the pod has been processed successfully or the max retries have been reached, remove the scheduling gate.
@@ -189,69 +228,37 @@ func (r *PodReconciler) processPod(ctx context.Context, pod *Pod) {
 	}
 }
 
-// applyMatchingPPCs applies the pre-filtered matching PodPlacementConfigs to the pod.
+// applyMatchingPPCs applies the NodeAffinityScoring plugin of the pre-filtered matching PodPlacementConfigs and of
+// the ClusterPodPlacementConfig to the pod, according to the merge strategy of the highest priority PodPlacementConfig.
 // The matchingPPCs slice should already be filtered to only include PPCs whose label selector matches the pod.
-func (r *PodReconciler) applyMatchingPPCs(ctx context.Context, matchingPPCs []multiarchv1beta1.PodPlacementConfig, pod *Pod) {
+func (r *PodReconciler) applyMatchingPPCs(ctx context.Context, cppc *multiarchv1beta1.ClusterPodPlacementConfig,
+	matchingPPCs []multiarchv1beta1.PodPlacementConfig, pod *Pod) {
 	log := ctrllog.FromContext(ctx).WithName("PodPlacementConfig")
 
-	// Sort the configurations by descending priority
-	sort.Slice(matchingPPCs, func(i, j int) bool {
-		return matchingPPCs[i].Spec.Priority > matchingPPCs[j].Spec.Priority
-	})
-
-	// For each matching namespace-scoped configuration, apply if plugin is enabled
-	for _, ppc := range matchingPPCs {
-		log.V(1).Info("Processing PodPlacementConfig", "namespace", ppc.Namespace, "name", ppc.Name)
-
-		// check if plugin is enabled
-		if !ppc.PluginsEnabled(common.NodeAffinityScoringPluginName) {
-			log.V(1).Info("Skipping PodPlacementConfig NodeAffinityScoring disabled", "namespace", ppc.Namespace, "name", ppc.Name)
-			continue
-		}
-
-		log.Info("Applying namespace-scoped config", "PodPlacementConfig", ppc.Name)
-		configSource := fmt.Sprintf("%s-%s", multiarchv1beta1.PodPlacementConfigKind, ppc.Name)
-		pod.SetPreferredArchNodeAffinity(ppc.Spec.Plugins.NodeAffinityScoring, configSource)
+	sources, strategy := nodeAffinityScoringSources(cppc, matchingPPCs)
+	for _, source := range sources {
+		log.V(1).Info("Applying config", "source", source.name, "mergeStrategy", strategy)
 	}
+	pod.setPreferredArchNodeAffinityFromSources(sources, strategy)
 }
 
 // trackSkippedMatchingConfigs tracks in the annotation which PPC/CPPC configs would have been applied
 // but were skipped due to user-defined architecture-related preferred affinity.
 // The matchingPPCs slice should already be filtered to only include PPCs whose label selector matches the pod.
 func (r *PodReconciler) trackSkippedMatchingConfigs(ctx context.Context, pod *Pod, cppc *multiarchv1beta1.ClusterPodPlacementConfig, matchingPPCs []multiarchv1beta1.PodPlacementConfig) {
-	// Sort by descending priority (same as applyMatchingPPCs)
-	sort.Slice(matchingPPCs, func(i, j int) bool {
-		return matchingPPCs[i].Spec.Priority > matchingPPCs[j].Spec.Priority
-	})
-
-	// Track skipped PodPlacementConfigs
-	for _, ppc := range matchingPPCs {
-		if !ppc.PluginsEnabled(common.NodeAffinityScoringPluginName) {
-			continue
-		}
-
-		configSource := fmt.Sprintf("%s-%s", multiarchv1beta1.PodPlacementConfigKind, ppc.Name)
-		// Track each platform term as skipped
-		for _, platform := range ppc.Spec.Plugins.NodeAffinityScoring.Platforms {
-			pod.trackAffinitySource(platform.Architecture, platform.Weight, configSource, false)
-		}
-	}
-
-	// Track skipped ClusterPodPlacementConfig
-	if cppc != nil && cppc.PluginsEnabled(common.NodeAffinityScoringPluginName) {
-		for _, platform := range cppc.Spec.Plugins.NodeAffinityScoring.Platforms {
-			pod.trackAffinitySource(platform.Architecture, platform.Weight, multiarchv1beta1.ClusterPodPlacementConfigKind, false)
-		}
+	sources, _ := nodeAffinityScoringSources(cppc, matchingPPCs)
+	for _, source := range sources {
+		pod.trackSkippedSource(source)
 	}
 }
 
 // pullSecretDataList returns the list of secrets data for the given pod given its imagePullSecrets field
-func (r *PodReconciler) pullSecretDataList(ctx context.Context, pod *Pod) ([][]byte, error) {
+func pullSecretDataList(ctx context.Context, clientSet kubernetes.Interface, pod *Pod) ([][]byte, error) {
 	log := ctrllog.FromContext(ctx)
 	secretAuths := make([][]byte, 0)
 	secretList := pod.getPodImagePullSecrets()
 	for _, pullsecret := range secretList {
-		secret, err := r.ClientSet.CoreV1().Secrets(pod.Namespace).Get(ctx, pullsecret, metav1.GetOptions{})
+		secret, err := clientSet.CoreV1().Secrets(pod.Namespace).Get(ctx, pullsecret, metav1.GetOptions{})
 		if err != nil {
 			log.Error(err, "Error getting secret", "secret", pullsecret)
 			continue
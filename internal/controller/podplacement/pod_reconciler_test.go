@@ -1,6 +1,7 @@
 package podplacement
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -187,6 +188,70 @@ var _ = Describe("Internal/Controller/Podplacement/PodReconciler", func() {
 				}).Should(Equal(""), "cache did not update with reverted ClusterPodPlacementConfig")
 			})
 		})
+		Context("with the ClusterPodPlacementConfig in Audit mode", Serial, func() {
+			It("does not gate the pod and records the node affinity that would have been set", func() {
+				cppc := &v1beta1.ClusterPodPlacementConfig{}
+				err := k8sClient.Get(ctx, crclient.ObjectKey{Name: common.SingletonResourceObjectName}, cppc)
+				Expect(err).NotTo(HaveOccurred(), "failed to get ClusterPodPlacementConfig")
+				cppc.Spec.Mode = common.PlacementModeAudit
+				err = k8sClient.Update(ctx, cppc)
+				Expect(err).NotTo(HaveOccurred(), "failed to update ClusterPodPlacementConfig")
+				Eventually(func() bool {
+					return clusterpodplacementconfig.GetClusterPodPlacementConfig().IsAuditMode()
+				}).Should(BeTrue(), "cache did not update with new ClusterPodPlacementConfig")
+
+				pod := NewPod().
+					WithContainersImages(fmt.Sprintf("%s/%s/%s:latest", registryAddress,
+						registry.PublicRepo, registry.ComputeNameByMediaType(imgspecv1.MediaTypeImageManifest))).
+					WithGenerateName("test-pod-audit-").
+					WithNamespace("test-namespace").
+					Build()
+				err = k8sClient.Create(ctx, pod)
+				Expect(err).NotTo(HaveOccurred(), "failed to create pod", err)
+				Expect(pod.Spec.SchedulingGates).NotTo(ContainElement(corev1.PodSchedulingGate{
+					Name: utils.SchedulingGateName,
+				}), "the pod should not be gated in Audit mode")
+
+				Eventually(func(g Gomega) {
+					err := k8sClient.Get(ctx, crclient.ObjectKeyFromObject(pod), pod)
+					g.Expect(err).NotTo(HaveOccurred(), "failed to get pod")
+					g.Expect(pod.Labels).To(HaveKeyWithValue(utils.AuditLabel, utils.AuditLabelValueAudited),
+						"audit label not found")
+					g.Expect(pod.Labels).To(HaveKeyWithValue(utils.SchedulingGateLabel, utils.LabelValueNotSet),
+						"unexpected scheduling gate label")
+					g.Expect(pod.Annotations).To(HaveKey(utils.AuditNodeAffinityAnnotation),
+						"audit node affinity annotation not found")
+					auditedNodeAffinity := &corev1.NodeAffinity{}
+					g.Expect(json.Unmarshal([]byte(pod.Annotations[utils.AuditNodeAffinityAnnotation]),
+						auditedNodeAffinity)).To(Succeed(), "failed to unmarshal the audited node affinity")
+					g.Expect(auditedNodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(Equal(
+						&corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{
+									MatchExpressions: []corev1.NodeSelectorRequirement{
+										{
+											Key:      utils.ArchLabel,
+											Operator: corev1.NodeSelectorOpIn,
+											Values:   []string{utils.ArchitecturePpc64le},
+										},
+									},
+								},
+							},
+						}), "unexpected audited node affinity")
+					g.Expect(pod.Spec.Affinity).To(BeNil(), "the pod's affinity should not be modified in Audit mode")
+				}).WithTimeout(e2e.WaitShort).Should(Succeed(), "failed to audit the pod")
+
+				// Cleanup: Revert CPPC changes
+				err = k8sClient.Get(ctx, crclient.ObjectKey{Name: common.SingletonResourceObjectName}, cppc)
+				Expect(err).NotTo(HaveOccurred(), "failed to get ClusterPodPlacementConfig")
+				cppc.Spec.Mode = common.PlacementModeEnforce
+				err = k8sClient.Update(ctx, cppc)
+				Expect(err).NotTo(HaveOccurred(), "failed to revert ClusterPodPlacementConfig")
+				Eventually(func() bool {
+					return clusterpodplacementconfig.GetClusterPodPlacementConfig().IsAuditMode()
+				}).Should(BeFalse(), "cache did not update with reverted ClusterPodPlacementConfig")
+			})
+		})
 		Context("with different pull secrets", func() {
 			It("handles images with global pull secrets correctly", func() {
 				// TODO: Test logic for handling a Pod with one container and image using global pull secret
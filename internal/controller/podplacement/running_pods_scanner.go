@@ -0,0 +1,225 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podplacement
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacement/metrics"
+	"github.com/openshift/multiarch-tuning-operator/pkg/informers/clusterpodplacementconfig"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+const (
+	// runningPodsScannerTick is the period at which the scanner checks whether a new scan is due.
+	runningPodsScannerTick = time.Minute
+	// runningPodsListPageSize is the maximum number of pods retrieved by each list request during a scan.
+	runningPodsListPageSize = 500
+)
+
+// RunningPodsScanner periodically inspects the images of the running pods and reports the ones scheduled on nodes
+// whose architecture is not supported by their images, when the RunningPodsScanner plugin is enabled in the
+// ClusterPodPlacementConfig. The mismatches are reported via a label and an event on the pods and in the
+// ArchitectureMismatchReport singleton. The scheduling constraints of the running pods are never modified.
+type RunningPodsScanner struct {
+	client    client.Client
+	apiReader client.Reader
+	clientSet *kubernetes.Clientset
+	scheme    *runtime.Scheme
+	recorder  record.EventRecorder
+	lastScan  time.Time
+}
+
+func NewRunningPodsScanner(client client.Client, apiReader client.Reader, clientSet *kubernetes.Clientset,
+	scheme *runtime.Scheme, recorder record.EventRecorder) *RunningPodsScanner {
+	return &RunningPodsScanner{
+		client:    client,
+		apiReader: apiReader,
+		clientSet: clientSet,
+		scheme:    scheme,
+		recorder:  recorder,
+	}
+}
+
+// NeedLeaderElection makes the scanner run on the leader replica only.
+func (s *RunningPodsScanner) NeedLeaderElection() bool {
+	return true
+}
+
+func (s *RunningPodsScanner) Start(ctx context.Context) error {
+	metrics.InitPodPlacementControllerMetrics()
+	log := ctrllog.FromContext(ctx, "runnable", "RunningPodsScanner")
+	ctx = ctrllog.IntoContext(ctx, log)
+	log.Info("Starting the running pods scanner")
+	wait.UntilWithContext(ctx, s.scanIfDue, runningPodsScannerTick)
+	log.Info("Stopping the running pods scanner")
+	return nil
+}
+
+// scanIfDue runs a scan if the RunningPodsScanner plugin is enabled and the configured interval has elapsed since the
+// last successful scan.
+func (s *RunningPodsScanner) scanIfDue(ctx context.Context) {
+	log := ctrllog.FromContext(ctx)
+	cppc := clusterpodplacementconfig.GetClusterPodPlacementConfig()
+	if cppc == nil || !cppc.PluginsEnabled(common.RunningPodsScannerPluginName) {
+		return
+	}
+	if time.Since(s.lastScan) < cppc.Spec.Plugins.RunningPodsScanner.Interval() {
+		return
+	}
+	log.V(1).Info("Scanning the running pods")
+	if err := s.scan(ctx, cppc); err != nil {
+		log.Error(err, "Failed to scan the running pods")
+		return
+	}
+	s.lastScan = time.Now()
+}
+
+// scan inspects the images of all the running pods and updates the ArchitectureMismatchReport.
+func (s *RunningPodsScanner) scan(ctx context.Context, cppc *v1beta1.ClusterPodPlacementConfig) error {
+	nodesArchitecture, err := s.nodesArchitecture(ctx)
+	if err != nil {
+		return err
+	}
+	status := v1beta1.ArchitectureMismatchReportStatus{}
+	podList := &corev1.PodList{}
+	for {
+		if err := s.apiReader.List(ctx, podList, client.MatchingFields{"status.phase": string(corev1.PodRunning)},
+			client.Limit(runningPodsListPageSize), client.Continue(podList.Continue)); err != nil {
+			return err
+		}
+		for i := range podList.Items {
+			s.scanPod(ctx, cppc, &podList.Items[i], nodesArchitecture, &status)
+		}
+		if podList.Continue == "" {
+			break
+		}
+	}
+	now := metav1.Now()
+	status.LastScanTime = &now
+	metrics.ScannedRunningPods.Set(float64(status.ScannedPods))
+	metrics.MismatchedRunningPods.Set(float64(status.MismatchedPods))
+	return s.updateReport(ctx, cppc, status)
+}
+
+// nodesArchitecture returns a map of the nodes' names to the value of their kubernetes.io/arch label.
+func (s *RunningPodsScanner) nodesArchitecture(ctx context.Context) (map[string]string, error) {
+	nodeList := &corev1.NodeList{}
+	if err := s.apiReader.List(ctx, nodeList); err != nil {
+		return nil, err
+	}
+	nodesArchitecture := make(map[string]string, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		if architecture, ok := node.Labels[utils.ArchLabel]; ok {
+			nodesArchitecture[node.Name] = architecture
+		}
+	}
+	return nodesArchitecture, nil
+}
+
+// scanPod compares the architectures supported by the images of a running pod with the architecture of its node.
+// If the node's architecture is not supported, the pod is labeled, an event is published the first time the mismatch
+// is found, and the mismatch is added to the given status. The pods in the namespaces ignored by the pod placement
+// operand are not scanned.
+func (s *RunningPodsScanner) scanPod(ctx context.Context, cppc *v1beta1.ClusterPodPlacementConfig, podObj *corev1.Pod,
+	nodesArchitecture map[string]string, status *v1beta1.ArchitectureMismatchReportStatus) {
+	nodeArchitecture, ok := nodesArchitecture[podObj.Spec.NodeName]
+	if !ok {
+		return
+	}
+	log := ctrllog.FromContext(ctx).WithValues("namespace", podObj.Namespace, "name", podObj.Name)
+	pod := newPod(podObj, ctx, s.recorder)
+	if pod.isNamespaceIgnored(cppc) {
+		return
+	}
+	// pullSecretDataList never returns an error: the secrets that cannot be retrieved are logged and skipped.
+	psdl, _ := pullSecretDataList(ctx, s.clientSet, pod)
+	supportedArchitectures, err := pod.intersectImagesArchitecture(psdl)
+	if err != nil {
+		log.V(1).Info("Unable to inspect the images of the running pod", "error", err)
+		return
+	}
+	status.ScannedPods++
+	if sets.New(supportedArchitectures...).Has(nodeArchitecture) {
+		return
+	}
+	log.V(2).Info("The running pod's images do not support the node architecture", "nodeArchitecture", nodeArchitecture,
+		"supportedArchitectures", supportedArchitectures)
+	status.MismatchedPods++
+	if len(status.Mismatches) < v1beta1.MaxReportedArchitectureMismatches {
+		status.Mismatches = append(status.Mismatches, v1beta1.ArchitectureMismatch{
+			PodNamespace:           pod.Namespace,
+			PodName:                pod.Name,
+			NodeName:               pod.Spec.NodeName,
+			NodeArchitecture:       nodeArchitecture,
+			SupportedArchitectures: supportedArchitectures,
+		})
+	}
+	if pod.Labels[utils.ArchitectureMismatchLabel] == nodeArchitecture {
+		// The mismatch was already reported by a previous scan
+		return
+	}
+	pod.EnsureLabel(utils.ArchitectureMismatchLabel, nodeArchitecture)
+	if err := s.client.Update(ctx, pod.PodObject()); err != nil {
+		log.Error(err, "Unable to label the running pod")
+		return
+	}
+	pod.PublishEvent(corev1.EventTypeWarning, ArchitectureMismatch,
+		fmt.Sprintf(ArchitectureMismatchMsg, nodeArchitecture, strings.Join(supportedArchitectures, ", ")))
+}
+
+// updateReport creates the ArchitectureMismatchReport singleton, if it does not exist, and updates its status.
+// The report is owned by the ClusterPodPlacementConfig and garbage collected with it.
+func (s *RunningPodsScanner) updateReport(ctx context.Context, cppc *v1beta1.ClusterPodPlacementConfig,
+	status v1beta1.ArchitectureMismatchReportStatus) error {
+	report := &v1beta1.ArchitectureMismatchReport{}
+	err := s.apiReader.Get(ctx, client.ObjectKey{Name: common.SingletonResourceObjectName}, report)
+	if apierrors.IsNotFound(err) {
+		report = &v1beta1.ArchitectureMismatchReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: common.SingletonResourceObjectName,
+			},
+		}
+		if err := ctrl.SetControllerReference(cppc, report, s.scheme); err != nil {
+			return err
+		}
+		if err := s.client.Create(ctx, report); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	report.Status = status
+	return s.client.Status().Update(ctx, report)
+}
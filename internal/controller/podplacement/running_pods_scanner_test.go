@@ -0,0 +1,75 @@
+package podplacement
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	. "github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
+	"github.com/openshift/multiarch-tuning-operator/pkg/testing/image/fake/registry"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+var _ = Describe("Internal/Controller/Podplacement/RunningPodsScanner", func() {
+	When("Scanning the running pods", func() {
+		It("reports the pods running on a node whose architecture is not supported by their images", func() {
+			By("Creating a node and a pod running on it with an image that does not support its architecture")
+			node := NewNodeBuilder().
+				WithName("running-pods-scanner-node").
+				WithLabel(utils.ArchLabel, utils.ArchitectureAmd64).
+				Build()
+			Expect(k8sClient.Create(ctx, node)).To(Succeed(), "failed to create node")
+			pod := NewPod().
+				WithContainersImages(fmt.Sprintf("%s/%s/%s:latest", registryAddress,
+					registry.PublicRepo, registry.ComputeNameByMediaType(imgspecv1.MediaTypeImageManifest))).
+				WithGenerateName("test-pod-scanner-").
+				WithNamespace("test-namespace").
+				WithNodeName(node.Name).
+				Build()
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed(), "failed to create pod")
+			pod.Status.Phase = corev1.PodRunning
+			Expect(k8sClient.Status().Update(ctx, pod)).To(Succeed(), "failed to set the pod phase to Running")
+
+			By("Running a scan")
+			cppc := &v1beta1.ClusterPodPlacementConfig{}
+			Expect(k8sClient.Get(ctx, crclient.ObjectKey{Name: common.SingletonResourceObjectName}, cppc)).To(Succeed(),
+				"failed to get ClusterPodPlacementConfig")
+			scanner := NewRunningPodsScanner(k8sClient, k8sClient, kubernetes.NewForConfigOrDie(cfg), scheme.Scheme, nil)
+			Expect(scanner.scan(ctx, cppc)).To(Succeed(), "failed to scan the running pods")
+
+			By("Verifying the pod is labeled and reported")
+			Expect(k8sClient.Get(ctx, crclient.ObjectKeyFromObject(pod), pod)).To(Succeed(), "failed to get pod")
+			Expect(pod.Labels).To(HaveKeyWithValue(utils.ArchitectureMismatchLabel, utils.ArchitectureAmd64),
+				"architecture mismatch label not found")
+			Expect(pod.Spec.Affinity).To(BeNil(), "the running pod's affinity should not be modified")
+			report := &v1beta1.ArchitectureMismatchReport{}
+			Expect(k8sClient.Get(ctx, crclient.ObjectKey{Name: common.SingletonResourceObjectName}, report)).To(Succeed(),
+				"failed to get the ArchitectureMismatchReport")
+			Expect(report.Status.LastScanTime).NotTo(BeNil(), "the last scan time should be set")
+			Expect(report.Status.MismatchedPods).To(BeNumerically(">=", 1), "unexpected number of mismatched pods")
+			Expect(report.Status.Mismatches).To(ContainElement(v1beta1.ArchitectureMismatch{
+				PodNamespace:           pod.Namespace,
+				PodName:                pod.Name,
+				NodeName:               node.Name,
+				NodeArchitecture:       utils.ArchitectureAmd64,
+				SupportedArchitectures: []string{utils.ArchitecturePpc64le},
+			}), "the pod should be listed in the report")
+			Expect(report.OwnerReferences).To(ContainElement(HaveField("UID", cppc.UID)),
+				"the report should be owned by the ClusterPodPlacementConfig")
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, pod)).To(Succeed(), "failed to delete pod")
+			Expect(k8sClient.Delete(ctx, node)).To(Succeed(), "failed to delete node")
+		})
+	})
+})
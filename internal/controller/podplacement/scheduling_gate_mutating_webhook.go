@@ -36,7 +36,6 @@ import (
 
 	"github.com/panjf2000/ants/v2"
 
-	"github.com/openshift/multiarch-tuning-operator/api/common"
 	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
 	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacement/metrics"
 	"github.com/openshift/multiarch-tuning-operator/pkg/informers/clusterpodplacementconfig"
@@ -92,9 +91,9 @@ func (a *PodSchedulingGateMutatingWebHook) Handle(ctx context.Context, req admis
 	// Filter to only PPCs that match this pod's labels - do this once for efficiency
 	matchingPPCs := pod.filterMatchingPPCs(ppcList)
 
-	// Set label to indicate if preferred affinity will be set by CPPC or any matching PPC
-	if (cppc != nil && cppc.PluginsEnabled(common.NodeAffinityScoringPluginName)) ||
-		pod.hasMatchingPPCWithPlugin(matchingPPCs) {
+	// Set label to indicate if preferred affinity will be set by CPPC or any matching PPC. The label is not predicted
+	// when the terms depend on the state collected by the NodeCapacitySyncer, which only runs in the controller.
+	if predictsPreferredNodeAffinity(cppc, matchingPPCs) {
 		pod.EnsureLabel(utils.PreferredNodeAffinityLabel, utils.LabelValueNotSet)
 	}
 	pod.EnsureLabel(utils.NodeAffinityLabel, utils.LabelValueNotSet)
@@ -105,6 +104,19 @@ func (a *PodSchedulingGateMutatingWebHook) Handle(ctx context.Context, req admis
 		return a.patchedPodResponse(pod.PodObject(), req)
 	}
 
+	if cppc.IsAuditMode() {
+		// In Audit mode, the pod is not gated. We only label it so that the pod placement controller can inspect it
+		// asynchronously and record the nodeAffinity that would have been set.
+		pod.EnsureLabel(utils.AuditLabel, utils.AuditLabelValuePending)
+		log.V(2).Info("Accepting pod for audit")
+		return a.patchedPodResponse(pod.PodObject(), req)
+	}
+
+	// The scheduling constraints of the ignored and audited pods are never modified: the equivalent architecture label
+	// keys are only normalized for the pods the operator gates.
+	if cppc.NormalizeArchitectureLabels() {
+		pod.normalizeArchitectureLabels(cppc)
+	}
 	pod.ensureSchedulingGate()
 	// We also add a label to the pod to indicate that the scheduling gate was added
 	// and this pod expects processing by the operator. That's useful for testing and debugging, but also gives the user
@@ -117,7 +129,6 @@ func (a *PodSchedulingGateMutatingWebHook) Handle(ctx context.Context, req admis
 	log.V(3).Info("Scheduling gate added to the pod, launching the event creation goroutine")
 	a.delayedSchedulingGatedEvent(ctx, pod.DeepCopy())
 	metrics.GatedPods.Inc()
-	metrics.GatedPodsGauge.Inc()
 	log.V(2).Info("Accepting pod")
 	return a.patchedPodResponse(pod.PodObject(), req)
 }
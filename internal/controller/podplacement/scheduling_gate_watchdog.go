@@ -0,0 +1,142 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podplacement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacement/metrics"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+)
+
+const (
+	// schedulingGateWatchdogPeriod is the period at which the watchdog looks for the pods gated for too long.
+	schedulingGateWatchdogPeriod = time.Minute
+	// gatedPodsListPageSize is the maximum number of pods retrieved by each list request of the watchdog.
+	gatedPodsListPageSize = 500
+)
+
+// SchedulingGateWatchdog removes the scheduling gate from the pods gated for longer than the schedulingGateTimeout
+// configured in the ClusterPodPlacementConfig. It runs in the operator, so that the pods are ungated even when the pod
+// placement controller is down or stuck. The ungated pods keep the node affinity set by the user, get the timed-out
+// value of the multiarch.openshift.io/scheduling-gate label and a warning event.
+type SchedulingGateWatchdog struct {
+	client    client.Client
+	clientSet kubernetes.Interface
+	recorder  record.EventRecorder
+}
+
+func NewSchedulingGateWatchdog(client client.Client, clientSet kubernetes.Interface,
+	recorder record.EventRecorder) *SchedulingGateWatchdog {
+	return &SchedulingGateWatchdog{
+		client:    client,
+		clientSet: clientSet,
+		recorder:  recorder,
+	}
+}
+
+// NeedLeaderElection makes the watchdog run on the leader replica only.
+func (w *SchedulingGateWatchdog) NeedLeaderElection() bool {
+	return true
+}
+
+func (w *SchedulingGateWatchdog) Start(ctx context.Context) error {
+	metrics.InitSchedulingGateWatchdogMetrics()
+	log := ctrllog.FromContext(ctx, "runnable", "SchedulingGateWatchdog")
+	ctx = ctrllog.IntoContext(ctx, log)
+	log.Info("Starting the scheduling gate watchdog")
+	wait.UntilWithContext(ctx, w.check, schedulingGateWatchdogPeriod)
+	log.Info("Stopping the scheduling gate watchdog")
+	return nil
+}
+
+// check ungates the pods gated for longer than the configured timeout. It does nothing if the timeout is not set or
+// if the ClusterPodPlacementConfig is being deleted, as the operator ungates the pods while deleting the operand.
+func (w *SchedulingGateWatchdog) check(ctx context.Context) {
+	log := ctrllog.FromContext(ctx)
+	cppc := &v1beta1.ClusterPodPlacementConfig{}
+	if err := w.client.Get(ctx, client.ObjectKey{Name: common.SingletonResourceObjectName}, cppc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Unable to get the ClusterPodPlacementConfig")
+		}
+		return
+	}
+	timeout := cppc.SchedulingGateTimeout()
+	if timeout == 0 || !cppc.DeletionTimestamp.IsZero() {
+		return
+	}
+	listOptions := metav1.ListOptions{
+		// The webhook labels the pods it gates, and the pods are gated as long as they are pending.
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			utils.SchedulingGateLabel: utils.SchedulingGateLabelValueGated,
+		}).String(),
+		FieldSelector: "status.phase=Pending",
+		Limit:         gatedPodsListPageSize,
+	}
+	for {
+		pods, err := w.clientSet.CoreV1().Pods("").List(ctx, listOptions)
+		if err != nil {
+			log.Error(err, "Unable to list the gated pods")
+			return
+		}
+		for i := range pods.Items {
+			w.ungateIfTimedOut(ctx, &pods.Items[i], timeout)
+		}
+		if pods.Continue == "" {
+			return
+		}
+		listOptions.Continue = pods.Continue
+	}
+}
+
+// ungateIfTimedOut removes the scheduling gate from the given pod if it has been gated for longer than the timeout.
+// The pods are gated by the webhook at creation, so the gating time is the age of the pod.
+// The pods deliberately kept gated by the KeepGated NoCompatibleNodesPolicy are not ungated: the pod placement
+// controller processes them periodically and removes the gate once a compatible node joins the cluster.
+// A failed update is retried at the next check.
+func (w *SchedulingGateWatchdog) ungateIfTimedOut(ctx context.Context, podObj *corev1.Pod, timeout time.Duration) {
+	pod := newPod(podObj, ctx, w.recorder)
+	gatedFor := time.Since(pod.CreationTimestamp.Time)
+	if !pod.HasSchedulingGate() || pod.hasNoCompatibleNodes() || gatedFor < timeout {
+		return
+	}
+	log := ctrllog.FromContext(ctx).WithValues("namespace", pod.Namespace, "name", pod.Name)
+	pod.RemoveGate(utils.SchedulingGateName)
+	pod.EnsureLabel(utils.SchedulingGateLabel, utils.SchedulingGateLabelValueTimedOut)
+	if _, err := w.clientSet.CoreV1().Pods(pod.Namespace).Update(ctx, pod.PodObject(), metav1.UpdateOptions{}); err != nil {
+		log.Error(err, "Unable to remove the scheduling gate from the timed out pod")
+		return
+	}
+	log.Info("Removed the scheduling gate from the timed out pod", "gatedFor", gatedFor.Round(time.Second))
+	metrics.SchedulingGateTimeouts.Inc()
+	pod.PublishEvent(corev1.EventTypeWarning, ArchitectureAwareSchedulingGateTimedOut,
+		fmt.Sprintf(SchedulingGateTimedOutMsg, timeout))
+}
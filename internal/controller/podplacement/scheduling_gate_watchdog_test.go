@@ -0,0 +1,81 @@
+package podplacement
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/multiarch-tuning-operator/internal/controller/podplacement/metrics"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
+
+	. "github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
+)
+
+func TestSchedulingGateWatchdog_ungateIfTimedOut(t *testing.T) {
+	tests := []struct {
+		name        string
+		age         time.Duration
+		gates       []string
+		labels      []string
+		wantUngated bool
+	}{
+		{
+			name:        "pod gated for longer than the timeout",
+			age:         time.Hour,
+			gates:       []string{utils.SchedulingGateName},
+			wantUngated: true,
+		},
+		{
+			name:        "pod gated for less than the timeout",
+			age:         time.Minute,
+			gates:       []string{utils.SchedulingGateName},
+			wantUngated: false,
+		},
+		{
+			name:        "pod kept gated by the KeepGated policy",
+			age:         time.Hour,
+			gates:       []string{utils.SchedulingGateName},
+			labels:      []string{utils.NoCompatibleNodesLabel, ""},
+			wantUngated: false,
+		},
+		{
+			name:        "pod with another scheduling gate only",
+			age:         time.Hour,
+			gates:       []string{"other-gate"},
+			wantUngated: false,
+		},
+	}
+	metrics.InitSchedulingGateWatchdogMetrics()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			pod := NewPod().WithName("test-pod").WithNamespace("test-namespace").
+				WithSchedulingGates(tt.gates...).
+				WithLabels(append([]string{utils.SchedulingGateLabel, utils.SchedulingGateLabelValueGated}, tt.labels...)...).Build()
+			pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-tt.age))
+			clientSet := fake.NewSimpleClientset(pod)
+			recorder := record.NewFakeRecorder(1)
+			w := NewSchedulingGateWatchdog(nil, clientSet, recorder)
+			w.ungateIfTimedOut(ctx, pod.DeepCopy(), 30*time.Minute)
+
+			got, err := clientSet.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+			g.Expect(err).NotTo(HaveOccurred())
+			if !tt.wantUngated {
+				g.Expect(got.Spec.SchedulingGates).To(Equal(pod.Spec.SchedulingGates))
+				g.Expect(got.Labels[utils.SchedulingGateLabel]).To(Equal(utils.SchedulingGateLabelValueGated))
+				g.Expect(recorder.Events).To(BeEmpty())
+				return
+			}
+			g.Expect(got.Spec.SchedulingGates).To(BeEmpty())
+			g.Expect(got.Labels[utils.SchedulingGateLabel]).To(Equal(utils.SchedulingGateLabelValueTimedOut))
+			g.Expect(recorder.Events).To(Receive(ContainSubstring(v1.EventTypeWarning + " " +
+				ArchitectureAwareSchedulingGateTimedOut)))
+		})
+	}
+}
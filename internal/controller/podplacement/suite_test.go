@@ -347,6 +347,15 @@ func runManager() {
 		ClientSet: clientset,
 		Recorder:  mgr.GetEventRecorderFor(utils.OperatorName), //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
 	}).SetupWithManager(mgr)).NotTo(HaveOccurred())
+	Expect((&PodAuditReconciler{
+		PodReconciler: PodReconciler{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+			Scheme:    mgr.GetScheme(),
+			ClientSet: clientset,
+			Recorder:  mgr.GetEventRecorderFor(utils.OperatorName), //nolint:staticcheck // MULTIARCH-6087: will be fixed with events API migration
+		},
+	}).SetupWithManager(mgr)).NotTo(HaveOccurred())
 	pool, err := ants.NewMultiPool(10, 10, ants.LeastTasks, ants.WithPreAlloc(true),
 		ants.WithNonblocking(true))
 	Expect(err).NotTo(HaveOccurred())
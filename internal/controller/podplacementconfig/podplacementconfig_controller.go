@@ -18,45 +18,198 @@ package podplacementconfig
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	"github.com/openshift/multiarch-tuning-operator/api/common"
 	multiarchv1beta1 "github.com/openshift/multiarch-tuning-operator/api/v1beta1"
+	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
 )
 
+// statusResyncPeriod is the period at which the status of a PodPlacementConfig is recomputed to account for the
+// pods created or deleted in its namespace.
+const statusResyncPeriod = time.Minute
+
 // PodPlacementConfigReconciler reconciles a PodPlacementConfig object
 type PodPlacementConfigReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	APIReader client.Reader
+	Scheme    *runtime.Scheme
 }
 
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=podplacementconfigs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=podplacementconfigs/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=multiarch.openshift.io,resources=podplacementconfigs/finalizers,verbs=update
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the PodPlacementConfig object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.14.1/pkg/reconcile
+// Reconcile updates the status of the PodPlacementConfig with the conditions, the number of pods it selects and the
+// number of pods it has been applied to, and the warnings about its interactions with the other PodPlacementConfigs
+// in the same namespace.
+// The status is recomputed periodically, as the pods are not watched.
 func (r *PodPlacementConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	log := log.FromContext(ctx)
+
+	ppc := &multiarchv1beta1.PodPlacementConfig{}
+	if err := r.Get(ctx, req.NamespacedName, ppc); err != nil {
+		log.V(2).Info("Unable to fetch PodPlacementConfig", "error", err)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !ppc.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	ppcList := &multiarchv1beta1.PodPlacementConfigList{}
+	if err := r.List(ctx, ppcList, client.InNamespace(ppc.Namespace)); err != nil {
+		log.Error(err, "Unable to list the PodPlacementConfigs")
+		return ctrl.Result{}, err
+	}
+	// The pods cache of the pod placement controller only includes the pending pods: we list the pods from the API server.
+	podList := &corev1.PodList{}
+	if err := r.APIReader.List(ctx, podList, client.InNamespace(ppc.Namespace)); err != nil {
+		log.Error(err, "Unable to list the pods")
+		return ctrl.Result{}, err
+	}
+	status := computeStatus(ppc, ppcList.Items, podList.Items)
+	if !equality.Semantic.DeepEqual(status, ppc.Status) {
+		log.V(1).Info("Updating the PodPlacementConfig status", "matchedPods", status.MatchedPods,
+			"appliedPods", status.AppliedPods, "warnings", status.Warnings)
+		ppc.Status = status
+		if err := r.Status().Update(ctx, ppc); err != nil {
+			log.Error(err, "Unable to update the PodPlacementConfig status")
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: statusResyncPeriod}, nil
+}
+
+// computeStatus computes the status of a PodPlacementConfig given the PodPlacementConfigs and the pods in its
+// namespace.
+func computeStatus(ppc *multiarchv1beta1.PodPlacementConfig, ppcs []multiarchv1beta1.PodPlacementConfig,
+	pods []corev1.Pod) multiarchv1beta1.PodPlacementConfigStatus {
+	status := multiarchv1beta1.PodPlacementConfigStatus{
+		Conditions: ppc.Status.DeepCopy().Conditions,
+	}
+	// overlaps and shadows count the pods selected by both this and the other PodPlacementConfigs, by name
+	overlaps := map[string]int32{}
+	shadows := map[string]int32{}
+	source := ppc.AffinitySource()
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed ||
+			!ppc.MatchesPodLabels(pod.Labels) {
+			continue
+		}
+		status.MatchedPods++
+		if isAppliedToPod(source, pod) {
+			status.AppliedPods++
+			if status.LastAppliedTime == nil || status.LastAppliedTime.Before(&pod.CreationTimestamp) {
+				status.LastAppliedTime = pod.CreationTimestamp.DeepCopy()
+			}
+		}
+		for j := range ppcs {
+			other := &ppcs[j]
+			if other.Name == ppc.Name || !other.MatchesPodLabels(pod.Labels) {
+				continue
+			}
+			overlaps[other.Name]++
+			if isShadowedBy(ppc, other) {
+				shadows[other.Name]++
+			}
+		}
+	}
+	for _, name := range sets.List(sets.KeySet(overlaps)) {
+		status.Warnings = append(status.Warnings, fmt.Sprintf(multiarchv1beta1.OverlappingSelectorsMsg, name, overlaps[name]))
+	}
+	for _, name := range sets.List(sets.KeySet(shadows)) {
+		status.Warnings = append(status.Warnings, fmt.Sprintf(multiarchv1beta1.PriorityShadowingMsg, shadows[name], name))
+	}
+	reason, message := validate(ppc)
+	status.Build(ppc.Generation, reason, message)
+	return status
+}
+
+// validate returns the reason and the message of the Valid condition when the PodPlacementConfig is not valid.
+// It returns empty strings when the PodPlacementConfig is valid.
+func validate(ppc *multiarchv1beta1.PodPlacementConfig) (string, string) {
+	if _, err := metav1.LabelSelectorAsSelector(ppc.Spec.LabelSelector); err != nil {
+		return multiarchv1beta1.InvalidLabelSelectorReason, fmt.Sprintf(multiarchv1beta1.InvalidLabelSelectorMsg, err.Error())
+	}
+	if !ppc.Spec.Plugins.AnyPluginEnabled() {
+		return multiarchv1beta1.NoPluginEnabledReason, multiarchv1beta1.NoPluginEnabledMsg
+	}
+	return "", ""
+}
+
+// isAppliedToPod returns true if the preferred node affinity sources annotation of the pod reports at least one
+// architecture applied from the given source.
+func isAppliedToPod(source string, pod *corev1.Pod) bool {
+	// Entries format: architecture:weight:source[:skipped]
+	for _, entry := range strings.Split(pod.Annotations[utils.PreferredNodeAffinitySourcesAnnotation], ",") {
+		if fields := strings.Split(entry, ":"); len(fields) == 3 && fields[2] == source {
+			return true
+		}
+	}
+	return false
+}
 
-	// TODO(user): your logic here
+// isShadowedBy returns true if the other PodPlacementConfig has a higher priority and the given PodPlacementConfig has
+// no effect on the pods selected by both: either the other PodPlacementConfig uses the Override merge strategy, or it
+// uses the FirstMatchWins merge strategy and sets the preferences for all the architectures of the given one.
+// The architectures of the CostAwareScoring plugin depend on the cost hints known at admission: they are only
+// considered set by the other PodPlacementConfig if it enables the CostAwareScoring plugin with the same source.
+func isShadowedBy(ppc, other *multiarchv1beta1.PodPlacementConfig) bool {
+	if other.Spec.Priority <= ppc.Spec.Priority ||
+		!hasPreferredAffinityPlugin(ppc) || !hasPreferredAffinityPlugin(other) {
+		return false
+	}
+	switch other.Spec.MergeStrategy {
+	case common.MergeStrategyOverride:
+		return true
+	case common.MergeStrategyMergeByMaxWeight, common.MergeStrategySum:
+		return false
+	}
+	if ppc.PluginsEnabled(common.CostAwareScoringPluginName) && (!other.PluginsEnabled(common.CostAwareScoringPluginName) ||
+		other.Spec.Plugins.CostAwareScoring.Source != ppc.Spec.Plugins.CostAwareScoring.Source) {
+		return false
+	}
+	architectures := nodeAffinityScoringArchitectures(ppc)
+	return (architectures.Len() > 0 || ppc.PluginsEnabled(common.CostAwareScoringPluginName)) &&
+		nodeAffinityScoringArchitectures(other).IsSuperset(architectures)
+}
+
+// hasPreferredAffinityPlugin returns true if the PodPlacementConfig enables any of the plugins providing preferred
+// node affinity terms.
+func hasPreferredAffinityPlugin(ppc *multiarchv1beta1.PodPlacementConfig) bool {
+	return ppc.PluginsEnabled(common.NodeAffinityScoringPluginName) || ppc.PluginsEnabled(common.CostAwareScoringPluginName)
+}
 
-	return ctrl.Result{}, nil
+// nodeAffinityScoringArchitectures returns the architectures of the NodeAffinityScoring plugin of the
+// PodPlacementConfig, if enabled.
+func nodeAffinityScoringArchitectures(ppc *multiarchv1beta1.PodPlacementConfig) sets.Set[string] {
+	architectures := sets.New[string]()
+	if !ppc.PluginsEnabled(common.NodeAffinityScoringPluginName) {
+		return architectures
+	}
+	for _, platform := range ppc.Spec.Plugins.NodeAffinityScoring.Platforms {
+		architectures.Insert(platform.Architecture)
+	}
+	return architectures
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *PodPlacementConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&multiarchv1beta1.PodPlacementConfig{}).
+		// The status updates do not change the generation and must not trigger a new reconciliation
+		For(&multiarchv1beta1.PodPlacementConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
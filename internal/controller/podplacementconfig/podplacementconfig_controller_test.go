@@ -18,14 +18,19 @@ package podplacementconfig
 
 import (
 	"fmt"
+	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/multiarch-tuning-operator/api/common"
+	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
 	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
 	"github.com/openshift/multiarch-tuning-operator/pkg/testing/builder"
 	"github.com/openshift/multiarch-tuning-operator/pkg/testing/framework"
@@ -268,3 +273,127 @@ var _ = Describe("Internal/Controller/PodPlacementConfig/PodPlacementConfigRecon
 		})
 	})
 })
+
+func TestComputeStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ppc := builder.NewPodPlacementConfig().
+		WithName("low-priority").
+		WithNamespace(testNamespace).
+		WithPriority(10).
+		WithLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}).
+		WithPlugins().
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 50).
+		Build()
+	higherPriorityPPC := builder.NewPodPlacementConfig().
+		WithName("high-priority").
+		WithNamespace(testNamespace).
+		WithPriority(20).
+		WithLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"tier": "backend"}}).
+		WithPlugins().
+		WithNodeAffinityScoring(true).
+		WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 80).
+		WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 20).
+		Build()
+	appliedAt := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	appliedPod := builder.NewPod().WithName("applied").WithLabels("app", "test").
+		WithAnnotations(map[string]string{
+			utils.PreferredNodeAffinitySourcesAnnotation: "arm64:50:" + ppc.AffinitySource(),
+		}).Build()
+	appliedPod.CreationTimestamp = appliedAt
+	shadowedPod := builder.NewPod().WithName("shadowed").WithLabels("app", "test", "tier", "backend").
+		WithAnnotations(map[string]string{
+			utils.PreferredNodeAffinitySourcesAnnotation: "arm64:80:" + higherPriorityPPC.AffinitySource() +
+				",amd64:20:" + higherPriorityPPC.AffinitySource() + ",arm64:50:" + ppc.AffinitySource() + ":skipped",
+		}).Build()
+	shadowedPod.CreationTimestamp = metav1.NewTime(appliedAt.Add(time.Minute))
+	terminatedPod := builder.NewPod().WithName("terminated").WithLabels("app", "test").
+		WithAnnotations(map[string]string{
+			utils.PreferredNodeAffinitySourcesAnnotation: "arm64:50:" + ppc.AffinitySource(),
+		}).Build()
+	terminatedPod.Status.Phase = corev1.PodSucceeded
+	notMatchingPod := builder.NewPod().WithName("not-matching").WithLabels("app", "other").Build()
+
+	status := computeStatus(ppc, []v1beta1.PodPlacementConfig{*ppc, *higherPriorityPPC},
+		[]corev1.Pod{*appliedPod, *shadowedPod, *terminatedPod, *notMatchingPod})
+
+	g.Expect(status.MatchedPods).To(Equal(int32(2)), "unexpected number of matched pods")
+	g.Expect(status.AppliedPods).To(Equal(int32(1)), "unexpected number of applied pods")
+	g.Expect(status.LastAppliedTime).To(HaveValue(Equal(appliedAt)), "unexpected last applied time")
+	g.Expect(status.Warnings).To(ConsistOf(
+		fmt.Sprintf(v1beta1.OverlappingSelectorsMsg, "high-priority", 1),
+		fmt.Sprintf(v1beta1.PriorityShadowingMsg, 1, "high-priority"),
+	), "unexpected warnings")
+	g.Expect(status.Conditions).To(ContainElements(
+		HaveField("Type", v1beta1.ValidType),
+		HaveField("Type", v1beta1.AppliedType),
+	), "missing conditions")
+
+	higherPriorityStatus := computeStatus(higherPriorityPPC, []v1beta1.PodPlacementConfig{*ppc, *higherPriorityPPC},
+		[]corev1.Pod{*appliedPod, *shadowedPod, *terminatedPod, *notMatchingPod})
+	g.Expect(higherPriorityStatus.Warnings).To(ConsistOf(
+		fmt.Sprintf(v1beta1.OverlappingSelectorsMsg, "low-priority", 1),
+	), "the higher priority PodPlacementConfig should not be reported as shadowed")
+}
+
+func TestIsShadowedBy(t *testing.T) {
+	newPPC := func(priority uint8) *builder.PodPlacementConfigBuilder {
+		return builder.NewPodPlacementConfig().WithName(fmt.Sprintf("priority-%d", priority)).WithPriority(priority)
+	}
+	tests := []struct {
+		name  string
+		ppc   *v1beta1.PodPlacementConfig
+		other *v1beta1.PodPlacementConfig
+		want  bool
+	}{
+		{
+			name:  "lower priority other",
+			ppc:   newPPC(20).WithNodeAffinityScoring(true).WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 50).Build(),
+			other: newPPC(10).WithNodeAffinityScoring(true).WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 80).Build(),
+			want:  false,
+		},
+		{
+			name: "FirstMatchWins other setting all the architectures",
+			ppc:  newPPC(10).WithNodeAffinityScoring(true).WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 50).Build(),
+			other: newPPC(20).WithNodeAffinityScoring(true).WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 80).
+				WithNodeAffinityScoringTerm(utils.ArchitectureAmd64, 20).Build(),
+			want: true,
+		},
+		{
+			name:  "Override other with the CostAwareScoring plugin only",
+			ppc:   newPPC(10).WithNodeAffinityScoring(true).WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 50).Build(),
+			other: newPPC(20).WithMergeStrategy(common.MergeStrategyOverride).WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 50).Build(),
+			want:  true,
+		},
+		{
+			name:  "Override other shadowing the CostAwareScoring plugin",
+			ppc:   newPPC(10).WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 50).Build(),
+			other: newPPC(20).WithMergeStrategy(common.MergeStrategyOverride).WithNodeAffinityScoring(true).WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 80).Build(),
+			want:  true,
+		},
+		{
+			name:  "FirstMatchWins other without the CostAwareScoring plugin",
+			ppc:   newPPC(10).WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 50).Build(),
+			other: newPPC(20).WithNodeAffinityScoring(true).WithNodeAffinityScoringTerm(utils.ArchitectureArm64, 80).Build(),
+			want:  false,
+		},
+		{
+			name:  "FirstMatchWins other with the CostAwareScoring plugin and the same source",
+			ppc:   newPPC(10).WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 50).Build(),
+			other: newPPC(20).WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 80).Build(),
+			want:  true,
+		},
+		{
+			name:  "FirstMatchWins other with the CostAwareScoring plugin and another source",
+			ppc:   newPPC(10).WithCostAwareScoring(true, plugins.CostHintsSourceNodeLabels, 50).Build(),
+			other: newPPC(20).WithCostAwareScoring(true, plugins.CostHintsSourceConfigMap, 80).Build(),
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(isShadowedBy(tt.ppc, tt.other)).To(Equal(tt.want))
+		})
+	}
+}
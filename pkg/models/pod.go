@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
 	"github.com/openshift/multiarch-tuning-operator/pkg/utils"
@@ -155,6 +158,21 @@ func (pod *Pod) IsFromDaemonSet() bool {
 	return false
 }
 
+// Workload returns the kind and name of the workload owning the pod: its controller, or the Deployment of its
+// ReplicaSet. The pods without a controller are their own workload.
+func (pod *Pod) Workload() (kind, name string) {
+	owner := metav1.GetControllerOf(&pod.Pod)
+	if owner == nil {
+		return "Pod", pod.Name
+	}
+	// The ReplicaSets of a Deployment are named after it, followed by the pod-template-hash of their pods.
+	if hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok && owner.Kind == "ReplicaSet" &&
+		strings.HasSuffix(owner.Name, "-"+hash) {
+		return "Deployment", strings.TrimSuffix(owner.Name, "-"+hash)
+	}
+	return owner.Kind, owner.Name
+}
+
 func (pod *Pod) ContainerNameFor(containerID string) (string, error) {
 	// The containerID is in the format: "runtime://<64-hex-chars>"
 	matched, err := regexp.MatchString(`^.+://[a-f0-9]{64}$`, containerID)
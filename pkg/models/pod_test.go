@@ -546,6 +546,75 @@ func TestPod_IsFromDaemonSet(t *testing.T) {
 	}
 }
 
+func TestPod_Workload(t *testing.T) {
+	tests := []struct {
+		name     string
+		pod      *v1.Pod
+		wantKind string
+		wantName string
+	}{
+		{
+			name:     "pod with no owner references",
+			pod:      builder.NewPod().WithName("test-pod").Build(),
+			wantKind: "Pod",
+			wantName: "test-pod",
+		},
+		{
+			name: "pod of a ReplicaSet of a Deployment",
+			pod: builder.NewPod().WithName("test-deployment-5d8f9c7b6-abcde").
+				WithLabels("pod-template-hash", "5d8f9c7b6").
+				WithOwnerReference(metav1.OwnerReference{
+					Kind:       "ReplicaSet",
+					Name:       "test-deployment-5d8f9c7b6",
+					Controller: utils.NewPtr(true),
+				}).Build(),
+			wantKind: "Deployment",
+			wantName: "test-deployment",
+		},
+		{
+			name: "pod of a standalone ReplicaSet",
+			pod: builder.NewPod().WithName("test-replicaset-abcde").
+				WithOwnerReference(metav1.OwnerReference{
+					Kind:       "ReplicaSet",
+					Name:       "test-replicaset",
+					Controller: utils.NewPtr(true),
+				}).Build(),
+			wantKind: "ReplicaSet",
+			wantName: "test-replicaset",
+		},
+		{
+			name: "pod of a StatefulSet",
+			pod: builder.NewPod().WithName("test-statefulset-0").
+				WithOwnerReference(metav1.OwnerReference{
+					Kind:       "StatefulSet",
+					Name:       "test-statefulset",
+					Controller: utils.NewPtr(true),
+				}).Build(),
+			wantKind: "StatefulSet",
+			wantName: "test-statefulset",
+		},
+		{
+			name: "pod with an owner reference that is not its controller",
+			pod: builder.NewPod().WithName("test-pod").
+				WithOwnerReference(metav1.OwnerReference{
+					Kind: "ConfigMap",
+					Name: "test-configmap",
+				}).Build(),
+			wantKind: "Pod",
+			wantName: "test-pod",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := NewPod(tt.pod, context.Background(), nil)
+			kind, name := pod.Workload()
+			if kind != tt.wantKind || name != tt.wantName {
+				t.Errorf("Workload() = %s/%s, want %s/%s", kind, name, tt.wantKind, tt.wantName)
+			}
+		})
+	}
+}
+
 func TestPod_ContainerNameFor(t *testing.T) {
 	type fields struct {
 		Pod      v1.Pod
@@ -54,6 +54,18 @@ func (p *ClusterPodPlacementConfigBuilder) WithExecFormatErrorMonitor(enabled bo
 	return p
 }
 
+func (p *ClusterPodPlacementConfigBuilder) WithRunningPodsScanner(enabled bool, intervalMinutes int32) *ClusterPodPlacementConfigBuilder {
+	if p.Spec.Plugins == nil {
+		p.Spec.Plugins = &plugins.Plugins{}
+	}
+	if p.Spec.Plugins.RunningPodsScanner == nil {
+		p.Spec.Plugins.RunningPodsScanner = &plugins.RunningPodsScanner{}
+	}
+	p.Spec.Plugins.RunningPodsScanner.Enabled = enabled
+	p.Spec.Plugins.RunningPodsScanner.IntervalMinutes = intervalMinutes
+	return p
+}
+
 func (p *ClusterPodPlacementConfigBuilder) WithNodeAffinityScoring(enabled bool) *ClusterPodPlacementConfigBuilder {
 	if p.Spec.Plugins == nil {
 		p.Spec.Plugins = &plugins.Plugins{}
@@ -76,7 +88,55 @@ func (p *ClusterPodPlacementConfigBuilder) WithNodeAffinityScoringTerm(architect
 	return p
 }
 
+func (p *ClusterPodPlacementConfigBuilder) WithNodeAffinityScoringMode(mode plugins.NodeAffinityScoringMode) *ClusterPodPlacementConfigBuilder {
+	if p.Spec.Plugins.NodeAffinityScoring == nil {
+		p.Spec.Plugins.NodeAffinityScoring = &plugins.NodeAffinityScoring{}
+	}
+	p.Spec.Plugins.NodeAffinityScoring.Mode = mode
+	return p
+}
+
+func (p *ClusterPodPlacementConfigBuilder) WithCostAwareScoring(enabled bool, source plugins.CostHintsSource, maxWeight int32) *ClusterPodPlacementConfigBuilder {
+	if p.Spec.Plugins == nil {
+		p.Spec.Plugins = &plugins.Plugins{}
+	}
+	p.Spec.Plugins.CostAwareScoring = &plugins.CostAwareScoring{
+		BasePlugin: plugins.BasePlugin{Enabled: enabled},
+		Source:     source,
+		MaxWeight:  maxWeight,
+	}
+	return p
+}
+
 func (p *ClusterPodPlacementConfigBuilder) WithFallbackArchitecture(architecture string) *ClusterPodPlacementConfigBuilder {
 	p.Spec.FallbackArchitecture = architecture
 	return p
 }
+
+func (p *ClusterPodPlacementConfigBuilder) WithMode(mode common.PlacementMode) *ClusterPodPlacementConfigBuilder {
+	p.Spec.Mode = mode
+	return p
+}
+
+func (p *ClusterPodPlacementConfigBuilder) WithArchitectureLabels(normalize bool, equivalentKeys ...string) *ClusterPodPlacementConfigBuilder {
+	p.Spec.ArchitectureLabels = &v1beta1.ArchitectureLabels{
+		EquivalentKeys: equivalentKeys,
+		Normalize:      normalize,
+	}
+	return p
+}
+
+func (p *ClusterPodPlacementConfigBuilder) WithArchitectureConstraintsPolicy(policy common.ArchitectureConstraintsPolicy) *ClusterPodPlacementConfigBuilder {
+	p.Spec.ArchitectureConstraintsPolicy = policy
+	return p
+}
+
+func (p *ClusterPodPlacementConfigBuilder) WithNamespaceEnrollment(mode common.NamespaceEnrollmentMode) *ClusterPodPlacementConfigBuilder {
+	p.Spec.NamespaceEnrollment = mode
+	return p
+}
+
+func (p *ClusterPodPlacementConfigBuilder) WithIgnoredNamespaces(namespaces ...string) *ClusterPodPlacementConfigBuilder {
+	p.Spec.IgnoredNamespaces = namespaces
+	return p
+}
@@ -1,6 +1,10 @@
 package builder
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
 )
 
@@ -44,6 +48,25 @@ func (p *ENoExecEventBuilder) WithContainerID(containerID string) *ENoExecEventB
 	return p
 }
 
+func (p *ENoExecEventBuilder) WithExecutable(executable, architecture string) *ENoExecEventBuilder {
+	p.Status.Executable = executable
+	p.Status.ExecutableArchitecture = architecture
+	return p
+}
+
+func (p *ENoExecEventBuilder) WithHostProcess(cgroupPath, command string) *ENoExecEventBuilder {
+	p.Status.CgroupPath = cgroupPath
+	p.Status.Command = command
+	return p
+}
+
+func (p *ENoExecEventBuilder) WithOutcome(outcome v1beta1.ENoExecEventOutcome, reason string, handledAt time.Time) *ENoExecEventBuilder {
+	p.Status.Outcome = outcome
+	p.Status.Reason = reason
+	p.Status.HandledAt = &metav1.Time{Time: handledAt}
+	return p
+}
+
 func (p *ENoExecEventBuilder) WithFinalizer(finalizer string) *ENoExecEventBuilder {
 	p.Finalizers = append(p.Finalizers, finalizer)
 	return p
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"github.com/openshift/multiarch-tuning-operator/api/common"
 	"github.com/openshift/multiarch-tuning-operator/api/common/plugins"
 	"github.com/openshift/multiarch-tuning-operator/api/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,7 +70,32 @@ func (p *PodPlacementConfigBuilder) WithNodeAffinityScoringTerm(architecture str
 	return p
 }
 
+func (p *PodPlacementConfigBuilder) WithNodeAffinityScoringMode(mode plugins.NodeAffinityScoringMode) *PodPlacementConfigBuilder {
+	if p.Spec.Plugins.NodeAffinityScoring == nil {
+		p.Spec.Plugins.NodeAffinityScoring = &plugins.NodeAffinityScoring{}
+	}
+	p.Spec.Plugins.NodeAffinityScoring.Mode = mode
+	return p
+}
+
+func (p *PodPlacementConfigBuilder) WithCostAwareScoring(enabled bool, source plugins.CostHintsSource, maxWeight int32) *PodPlacementConfigBuilder {
+	if p.Spec.Plugins == nil {
+		p.Spec.Plugins = &plugins.LocalPlugins{}
+	}
+	p.Spec.Plugins.CostAwareScoring = &plugins.CostAwareScoring{
+		BasePlugin: plugins.BasePlugin{Enabled: enabled},
+		Source:     source,
+		MaxWeight:  maxWeight,
+	}
+	return p
+}
+
 func (p *PodPlacementConfigBuilder) WithPriority(priority uint8) *PodPlacementConfigBuilder {
 	p.Spec.Priority = priority
 	return p
 }
+
+func (p *PodPlacementConfigBuilder) WithMergeStrategy(mergeStrategy common.MergeStrategy) *PodPlacementConfigBuilder {
+	p.Spec.MergeStrategy = mergeStrategy
+	return p
+}
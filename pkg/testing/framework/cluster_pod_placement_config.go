@@ -102,6 +102,7 @@ func getENoExecEventsDeploymentObjects() []client.Object {
 		builder.NewServiceAccount().WithName(utils.EnoexecControllerName).WithNamespace(utils.Namespace()).Build(),
 		builder.NewClusterRole().WithName(utils.EnoexecControllerName).Build(),
 		builder.NewClusterRoleBinding().WithName(utils.EnoexecControllerName).Build(),
+		builder.NewClusterRole().WithName(utils.ExecFormatErrorReportViewer).Build(),
 		builder.NewRole().WithName(utils.EnoexecControllerName).WithNamespace(utils.Namespace()).Build(),
 		builder.NewRoleBinding().WithName(utils.EnoexecControllerName).WithNamespace(utils.Namespace()).Build(),
 	}
@@ -115,6 +116,7 @@ func getENoExecEventsDaemonSetObjects() []client.Object {
 		builder.NewRole().WithName(utils.EnoexecDaemonSet).WithNamespace(utils.Namespace()).Build(),
 		builder.NewRoleBinding().WithName(utils.EnoexecDaemonSet).WithNamespace(utils.Namespace()).Build(),
 		builder.NewDaemonSet().WithName(utils.EnoexecDaemonSet).WithNamespace(utils.Namespace()).Build(),
+		builder.NewService().WithName(utils.EnoexecDaemonSet).WithNamespace(utils.Namespace()).Build(),
 	}
 }
 
@@ -55,6 +55,7 @@ const (
 	SchedulingGateLabel                    = "multiarch.openshift.io/scheduling-gate"
 	SchedulingGateLabelValueGated          = "gated"
 	SchedulingGateLabelValueRemoved        = "removed"
+	SchedulingGateLabelValueTimedOut       = "timed-out"
 	PodPlacementFinalizerName              = "finalizers.multiarch.openshift.io/pod-placement"
 	CPPCNoPPCObjectFinalizer               = "finalizers.multiarch.openshift.io/no-pod-placement-config"
 	SingleArchLabel                        = "multiarch.openshift.io/single-arch"
@@ -64,6 +65,41 @@ const (
 	ImageInspectionErrorLabel              = "multiarch.openshift.io/image-inspect-error"
 	ImageInspectionErrorCountLabel         = "multiarch.openshift.io/image-inspect-error-count"
 	LabelGroup                             = "multiarch.openshift.io"
+	// AuditLabel is set on the pods processed while the pod placement operand runs in Audit mode.
+	// Its value tracks the state of the audit: pending (set by the webhook), audited, ignored or error.
+	AuditLabel             = "multiarch.openshift.io/audit"
+	AuditLabelValuePending = "pending"
+	AuditLabelValueAudited = "audited"
+	AuditLabelValueIgnored = "ignored"
+	AuditLabelValueError   = "error"
+	// AuditNodeAffinityAnnotation stores the JSON-encoded nodeAffinity that the pod placement operand would have set
+	// on a pod if it was running in Enforce mode.
+	AuditNodeAffinityAnnotation = "multiarch.openshift.io/audit-node-affinity"
+	// ArchitectureMismatchLabel is set by the running pods scanner on the running pods whose images do not support the
+	// architecture of their node. Its value is the architecture of the node.
+	ArchitectureMismatchLabel = "multiarch.openshift.io/arch-mismatch"
+	// NoCompatibleNodesLabel is set on the pods whose images support none of the architectures of the ready and
+	// schedulable nodes.
+	NoCompatibleNodesLabel = "multiarch.openshift.io/no-compatible-nodes"
+	// ArchitectureCostLabel is the node label read by the CostAwareScoring plugin to get the cost of the
+	// architecture of the node.
+	ArchitectureCostLabel = "multiarch.openshift.io/architecture-cost"
+	// ArchitectureCostHintsConfigMapName is the name of the ConfigMap, in the namespace of the operator, read by the
+	// CostAwareScoring plugin to get the cost of each architecture.
+	ArchitectureCostHintsConfigMapName = "architecture-cost-hints"
+	// NormalizedArchitectureLabelsAnnotation lists the architecture-equivalent node label keys that the pod placement
+	// webhook rewrote to kubernetes.io/arch in the nodeSelector and nodeAffinity of a pod.
+	NormalizedArchitectureLabelsAnnotation = "multiarch.openshift.io/normalized-arch-labels"
+	// ContainerArchitecturesAnnotation stores the architectures supported by the image of each container of a pod, as a
+	// JSON object mapping the container names to the lists of architectures.
+	ContainerArchitecturesAnnotation = "multiarch.openshift.io/container-architectures"
+	// NamespaceOptInLabel enrolls a namespace in the pod placement operand when the ClusterPodPlacementConfig
+	// namespaceEnrollment mode is OptIn.
+	NamespaceOptInLabel = "multiarch.openshift.io/include-pod-placement"
+	// PodOptOutLabel excludes a pod from the pod placement operand when set as a label or as an annotation of the pod.
+	// The label prevents the pods from reaching the pod placement webhook; the annotation is honoured by the webhook
+	// and the controller.
+	PodOptOutLabel = "multiarch.openshift.io/exclude-pod-placement"
 )
 
 const (
@@ -90,13 +126,18 @@ const (
 	UnknownContainer             = "unknown-container" // Used when the container name is not known or not provided
 	EnoexecControllerName        = "enoexec-event-handler-controller"
 	EnoexecDaemonSet             = "enoexec-event-daemon"
+	// ExecFormatErrorReportViewer is the name of the ClusterRole aggregated to the view, edit and admin roles, allowing
+	// the users of a namespace to read its ExecFormatErrorReport.
+	ExecFormatErrorReportViewer = "exec-format-error-report-viewer"
 )
 
 func AllSupportedArchitecturesSet() sets.Set[string] {
 	return sets.New(ArchitectureAmd64, ArchitectureArm64, ArchitecturePpc64le, ArchitectureS390x)
 }
 
-func ExecFormatErrorEventMessage(containerName, nodeArch string) string {
+// ExecFormatErrorEventMessage returns the message of the event published on the pods running a binary not compatible
+// with the architecture of their node. The executable and its architecture are omitted from the message if empty.
+func ExecFormatErrorEventMessage(containerName, nodeArch, executable, executableArch string) string {
 	var b strings.Builder
 
 	if containerName == UnknownContainer {
@@ -105,7 +146,14 @@ func ExecFormatErrorEventMessage(containerName, nodeArch string) string {
 		fmt.Fprintf(&b, "Container %q ", containerName)
 	}
 
-	b.WriteString("is running a binary")
+	if executable != "" {
+		fmt.Fprintf(&b, "is running the binary %q", executable)
+	} else {
+		b.WriteString("is running a binary")
+	}
+	if executableArch != "" {
+		fmt.Fprintf(&b, " built for %s", executableArch)
+	}
 	b.WriteString(" that is not compatible with the node architecture")
 	if nodeArch != "" {
 		fmt.Fprintf(&b, " (%s)", nodeArch)
@@ -117,3 +165,35 @@ the target node's architecture.`)
 
 	return b.String()
 }
+
+// HostExecFormatErrorEventMessage returns the message of the event published on the nodes running a process, outside
+// of the pods, that executed a binary not compatible with the architecture of the node. The empty fields are omitted
+// from the message.
+func HostExecFormatErrorEventMessage(command, cgroupPath, nodeArch, executable, executableArch string) string {
+	var b strings.Builder
+
+	if command != "" {
+		fmt.Fprintf(&b, "The host process %q", command)
+	} else {
+		b.WriteString("A host process")
+	}
+	if cgroupPath != "" {
+		fmt.Fprintf(&b, " in the cgroup %s", cgroupPath)
+	}
+
+	if executable != "" {
+		fmt.Fprintf(&b, " executed the binary %q", executable)
+	} else {
+		b.WriteString(" executed a binary")
+	}
+	if executableArch != "" {
+		fmt.Fprintf(&b, " built for %s", executableArch)
+	}
+	b.WriteString(" that is not compatible with the node architecture")
+	if nodeArch != "" {
+		fmt.Fprintf(&b, " (%s)", nodeArch)
+	}
+	b.WriteString(". Please ensure that the binaries installed on the node are built for its architecture.")
+
+	return b.String()
+}